@@ -1,11 +1,21 @@
 package main
 
 import (
+	"os"
+
 	"github.com/RJPearson94/terraform-provider-twilio/twilio"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == twilio.ConvertStudioFlowCommand {
+		os.Exit(twilio.ConvertStudioFlow(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == twilio.MigrateChatStateCommand {
+		os.Exit(twilio.MigrateChatState(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: twilio.Provider,
 	})
@@ -1,12 +1,17 @@
 package common
 
 import (
+	apiExtensions "github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010"
+	flexExtensions "github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/flex/v1"
+	numbersV1 "github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v1"
+	numbers "github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v2"
 	accounts "github.com/RJPearson94/twilio-sdk-go/service/accounts/v1"
 	api "github.com/RJPearson94/twilio-sdk-go/service/api/v2010"
 	chat "github.com/RJPearson94/twilio-sdk-go/service/chat/v2"
 	conversations "github.com/RJPearson94/twilio-sdk-go/service/conversations/v1"
 	flex "github.com/RJPearson94/twilio-sdk-go/service/flex/v1"
 	messaging "github.com/RJPearson94/twilio-sdk-go/service/messaging/v1"
+	monitor "github.com/RJPearson94/twilio-sdk-go/service/monitor/v1"
 	proxy "github.com/RJPearson94/twilio-sdk-go/service/proxy/v1"
 	serverless "github.com/RJPearson94/twilio-sdk-go/service/serverless/v1"
 	studio "github.com/RJPearson94/twilio-sdk-go/service/studio/v2"
@@ -21,18 +26,23 @@ type TwilioClient struct {
 	AccountSid       string
 	TerraformVersion string
 
-	Accounts      *accounts.Accounts
-	API           *api.V2010
-	Chat          *chat.Chat
-	Conversations *conversations.Conversations
-	Flex          *flex.Flex
-	Messaging     *messaging.Messaging
-	Proxy         *proxy.Proxy
-	Serverless    *serverless.Serverless
-	SIPTrunking   *trunking.Trunking
-	Studio        *studio.Studio
-	Sync          *sync.Sync
-	TaskRouter    *taskrouter.TaskRouter
-	Verify        *verify.Verify
-	Video         *video.Video
+	Accounts       *accounts.Accounts
+	API            *api.V2010
+	APIExtensions  *apiExtensions.V2010
+	Chat           *chat.Chat
+	Conversations  *conversations.Conversations
+	Flex           *flex.Flex
+	FlexExtensions *flexExtensions.Flex
+	Messaging      *messaging.Messaging
+	Monitor        *monitor.Monitor
+	Numbers        *numbers.Numbers
+	NumbersV1      *numbersV1.Numbers
+	Proxy          *proxy.Proxy
+	Serverless     *serverless.Serverless
+	SIPTrunking    *trunking.Trunking
+	Studio         *studio.Studio
+	Sync           *sync.Sync
+	TaskRouter     *taskrouter.TaskRouter
+	Verify         *verify.Verify
+	Video          *video.Video
 }
@@ -2,6 +2,10 @@ package twilio
 
 import (
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	apiExtensions "github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010"
+	flexExtensions "github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/flex/v1"
+	numbersV1 "github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v1"
+	numbers "github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v2"
 	"github.com/RJPearson94/twilio-sdk-go/client"
 	accounts "github.com/RJPearson94/twilio-sdk-go/service/accounts/v1"
 	api "github.com/RJPearson94/twilio-sdk-go/service/api/v2010"
@@ -9,6 +13,7 @@ import (
 	conversations "github.com/RJPearson94/twilio-sdk-go/service/conversations/v1"
 	flex "github.com/RJPearson94/twilio-sdk-go/service/flex/v1"
 	messaging "github.com/RJPearson94/twilio-sdk-go/service/messaging/v1"
+	monitor "github.com/RJPearson94/twilio-sdk-go/service/monitor/v1"
 	proxy "github.com/RJPearson94/twilio-sdk-go/service/proxy/v1"
 	serverless "github.com/RJPearson94/twilio-sdk-go/service/serverless/v1"
 	studio "github.com/RJPearson94/twilio-sdk-go/service/studio/v2"
@@ -60,20 +65,25 @@ func (config *Config) Client() (interface{}, diag.Diagnostics) {
 		AccountSid:       config.AccountSid,
 		TerraformVersion: config.terraformVersion,
 
-		Accounts:      accounts.New(sess, sdkConfig),
-		API:           api.New(sess, sdkConfig),
-		Chat:          chat.New(sess, sdkConfig),
-		Conversations: conversations.New(sess, sdkConfig),
-		Flex:          flex.New(sess, sdkConfig),
-		Messaging:     messaging.New(sess, sdkConfig),
-		Proxy:         proxy.New(sess, sdkConfig),
-		Serverless:    serverless.New(sess, sdkConfig),
-		SIPTrunking:   trunking.New(sess, sdkConfig),
-		Studio:        studio.New(sess, sdkConfig),
-		Sync:          sync.New(sess, sdkConfig),
-		TaskRouter:    taskrouter.New(sess, sdkConfig),
-		Verify:        verify.New(sess, sdkConfig),
-		Video:         video.New(sess, sdkConfig),
+		Accounts:       accounts.New(sess, sdkConfig),
+		API:            api.New(sess, sdkConfig),
+		APIExtensions:  apiExtensions.New(sess, sdkConfig),
+		Chat:           chat.New(sess, sdkConfig),
+		Conversations:  conversations.New(sess, sdkConfig),
+		Flex:           flex.New(sess, sdkConfig),
+		FlexExtensions: flexExtensions.New(sess, sdkConfig),
+		Messaging:      messaging.New(sess, sdkConfig),
+		Monitor:        monitor.New(sess, sdkConfig),
+		Numbers:        numbers.New(sess, sdkConfig),
+		NumbersV1:      numbersV1.New(sess, sdkConfig),
+		Proxy:          proxy.New(sess, sdkConfig),
+		Serverless:     serverless.New(sess, sdkConfig),
+		SIPTrunking:    trunking.New(sess, sdkConfig),
+		Studio:         studio.New(sess, sdkConfig),
+		Sync:           sync.New(sess, sdkConfig),
+		TaskRouter:     taskrouter.New(sess, sdkConfig),
+		Verify:         verify.New(sess, sdkConfig),
+		Video:          video.New(sess, sdkConfig),
 	}
 	return client, nil
 }
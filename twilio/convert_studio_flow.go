@@ -0,0 +1,91 @@
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/studio/converter"
+)
+
+// ConvertStudioFlowCommand is the name of the provider binary subcommand which converts an existing Studio Flow definition into HCL
+const ConvertStudioFlowCommand = "convert-studio-flow"
+
+// ConvertStudioFlow reads a Studio Flow definition from a file or from the Twilio API and writes the equivalent widget based HCL to stdout.
+// The credentials used to fetch the flow are read from the same environment variables as the provider configuration
+func ConvertStudioFlow(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(ConvertStudioFlowCommand, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	file := flags.String("file", "", "Path to a file containing the Studio Flow definition JSON")
+	flowSid := flags.String("flow-sid", "", "SID of the Studio Flow to fetch the definition of")
+	name := flags.String("name", "definition", "Name of the generated twilio_studio_flow_definition data source")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if (*file == "") == (*flowSid == "") {
+		fmt.Fprintln(stderr, "Exactly one of -file or -flow-sid must be specified")
+		flags.Usage()
+		return 2
+	}
+
+	definition, err := readStudioFlowDefinition(*file, *flowSid)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	hcl, err := converter.Convert(definition, *name)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	fmt.Fprint(stdout, hcl)
+	return 0
+}
+
+func readStudioFlowDefinition(file string, flowSid string) (string, error) {
+	if file != "" {
+		definition, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("Failed to read flow definition file: %s", err.Error())
+		}
+		return string(definition), nil
+	}
+
+	skipCredentialValidation, _ := strconv.ParseBool(os.Getenv("TWILIO_SKIP_CREDENTIAL_VALIDATION"))
+	config := Config{
+		AccountSid:               os.Getenv("TWILIO_ACCOUNT_SID"),
+		AuthToken:                os.Getenv("TWILIO_AUTH_TOKEN"),
+		APIKey:                   os.Getenv("TWILIO_API_KEY"),
+		APISecret:                os.Getenv("TWILIO_API_SECRET"),
+		SkipCredentialValidation: skipCredentialValidation,
+		RetryAttempts:            3,
+		BackoffInterval:          5000,
+		Edge:                     os.Getenv("TWILIO_EDGE"),
+		Region:                   os.Getenv("TWILIO_REGION"),
+	}
+
+	client, diags := config.Client()
+	if diags.HasError() {
+		return "", fmt.Errorf("Failed to create Twilio client: %s", diags[0].Summary)
+	}
+
+	getResponse, err := client.(*common.TwilioClient).Studio.Flow(flowSid).FetchWithContext(context.Background())
+	if err != nil {
+		return "", fmt.Errorf("Failed to read studio flow: %s", err.Error())
+	}
+
+	definition, err := json.Marshal(getResponse.Definition)
+	if err != nil {
+		return "", fmt.Errorf("Failed to marshal studio flow definition: %s", err.Error())
+	}
+	return string(definition), nil
+}
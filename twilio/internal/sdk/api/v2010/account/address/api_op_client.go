@@ -0,0 +1,39 @@
+// Package address contains the address API operations which are not currently supported by the twilio-sdk-go
+package address
+
+import (
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/address/dependent_phone_numbers"
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+// Client for managing a specific address resource
+// See https://www.twilio.com/docs/usage/api/address for more details
+type Client struct {
+	client *client.Client
+
+	accountSid string
+	sid        string
+
+	DependentPhoneNumbers *dependent_phone_numbers.Client
+}
+
+// ClientProperties are the properties required to manage the address resources
+type ClientProperties struct {
+	AccountSid string
+	Sid        string
+}
+
+// New creates a new instance of the address client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		accountSid: properties.AccountSid,
+		sid:        properties.Sid,
+
+		DependentPhoneNumbers: dependent_phone_numbers.New(client, dependent_phone_numbers.ClientProperties{
+			AccountSid: properties.AccountSid,
+			AddressSid: properties.Sid,
+		}),
+	}
+}
@@ -0,0 +1,29 @@
+// Package dependent_phone_numbers contains the dependent phone number API operations which are not currently supported by the twilio-sdk-go
+package dependent_phone_numbers
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing dependent phone number resources
+// See https://www.twilio.com/docs/usage/api/address#list-dependent-pn-subresources for more details
+type Client struct {
+	client *client.Client
+
+	accountSid string
+	addressSid string
+}
+
+// ClientProperties are the properties required to manage the dependent phone number resources
+type ClientProperties struct {
+	AccountSid string
+	AddressSid string
+}
+
+// New creates a new instance of the dependent phone numbers client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		accountSid: properties.AccountSid,
+		addressSid: properties.AddressSid,
+	}
+}
@@ -0,0 +1,129 @@
+package dependent_phone_numbers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+// DependentPhoneNumbersPageOptions defines the query options for the api operation
+type DependentPhoneNumbersPageOptions struct {
+	PageSize  *int
+	Page      *int
+	PageToken *string
+}
+
+type PageDependentPhoneNumberResponse struct {
+	AccountSid          string  `json:"account_sid"`
+	EmergencyAddressSid *string `json:"emergency_address_sid,omitempty"`
+	EmergencyStatus     string  `json:"emergency_status"`
+	FriendlyName        string  `json:"friendly_name"`
+	PhoneNumber         string  `json:"phone_number"`
+	Sid                 string  `json:"sid"`
+}
+
+// DependentPhoneNumbersPageResponse defines the response fields for the dependent phone numbers page
+type DependentPhoneNumbersPageResponse struct {
+	DependentPhoneNumbers []PageDependentPhoneNumberResponse `json:"dependent_phone_numbers"`
+	End                   int                                `json:"end"`
+	FirstPageURI          string                             `json:"first_page_uri"`
+	NextPageURI           *string                            `json:"next_page_uri,omitempty"`
+	Page                  int                                `json:"page"`
+	PageSize              int                                `json:"page_size"`
+	PreviousPageURI       *string                            `json:"previous_page_uri,omitempty"`
+	Start                 int                                `json:"start"`
+	URI                   string                             `json:"uri"`
+}
+
+// PageWithContext retrieves a page of dependent phone numbers
+// See https://www.twilio.com/docs/usage/api/address#list-dependent-pn-subresources for more details
+func (c Client) PageWithContext(context context.Context, options *DependentPhoneNumbersPageOptions) (*DependentPhoneNumbersPageResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "/Accounts/{accountSid}/Addresses/{addressSid}/DependentPhoneNumbers.json",
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+			"addressSid": c.addressSid,
+		},
+		QueryParams: utils.StructToURLValues(options),
+	}
+
+	response := &DependentPhoneNumbersPageResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DependentPhoneNumbersPaginator defines the fields for makings paginated api calls
+// DependentPhoneNumbers is an array of dependent phone numbers that have been returned from all of the page calls
+type DependentPhoneNumbersPaginator struct {
+	client  *Client
+	options *DependentPhoneNumbersPageOptions
+
+	CurrentPage           *DependentPhoneNumbersPageResponse
+	DependentPhoneNumbers []PageDependentPhoneNumberResponse
+	Error                 error
+}
+
+// NewDependentPhoneNumbersPaginator creates a new instance of the paginator for Page.
+func (c *Client) NewDependentPhoneNumbersPaginator() *DependentPhoneNumbersPaginator {
+	return &DependentPhoneNumbersPaginator{
+		client:                c,
+		DependentPhoneNumbers: make([]PageDependentPhoneNumberResponse, 0),
+	}
+}
+
+// NextWithContext retrieves the next page of results.
+// NextWithContext will return false when either an error occurs or there are no more pages to iterate
+func (p *DependentPhoneNumbersPaginator) NextWithContext(context context.Context) bool {
+	options := p.options
+
+	if options == nil {
+		options = &DependentPhoneNumbersPageOptions{}
+	}
+
+	if p.CurrentPage != nil {
+		nextPage := p.CurrentPage.NextPageURI
+
+		if nextPage == nil {
+			return false
+		}
+
+		parsedURL, err := url.Parse(*nextPage)
+		if err != nil {
+			p.Error = err
+			return false
+		}
+
+		options.PageToken = utils.String(parsedURL.Query().Get("PageToken"))
+
+		page, pageErr := strconv.Atoi(parsedURL.Query().Get("Page"))
+		if pageErr != nil {
+			p.Error = pageErr
+			return false
+		}
+		options.Page = utils.Int(page)
+
+		pageSize, pageSizeErr := strconv.Atoi(parsedURL.Query().Get("PageSize"))
+		if pageSizeErr != nil {
+			p.Error = pageSizeErr
+			return false
+		}
+		options.PageSize = utils.Int(pageSize)
+	}
+
+	resp, err := p.client.PageWithContext(context, options)
+	p.CurrentPage = resp
+	p.Error = err
+
+	if p.Error == nil {
+		p.DependentPhoneNumbers = append(p.DependentPhoneNumbers, resp.DependentPhoneNumbers...)
+	}
+
+	return p.Error == nil
+}
@@ -0,0 +1,77 @@
+// Package account contains the account API operations which are not currently supported by the twilio-sdk-go
+package account
+
+import (
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/address"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/incoming_phone_number"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/outgoing_caller_id"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/outgoing_caller_ids"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/short_code"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/short_codes"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/validation_requests"
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+// Client for managing a specific account resource
+// See https://www.twilio.com/docs/iam/api/account for more details
+type Client struct {
+	client *client.Client
+
+	sid string
+
+	Address             func(string) *address.Client
+	IncomingPhoneNumber func(string) *incoming_phone_number.Client
+	OutgoingCallerID    func(string) *outgoing_caller_id.Client
+	OutgoingCallerIDs   *outgoing_caller_ids.Client
+	ShortCode           func(string) *short_code.Client
+	ShortCodes          *short_codes.Client
+	ValidationRequests  *validation_requests.Client
+}
+
+// ClientProperties are the properties required to manage the account resources
+type ClientProperties struct {
+	Sid string
+}
+
+// New creates a new instance of the account client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		sid: properties.Sid,
+
+		Address: func(addressSid string) *address.Client {
+			return address.New(client, address.ClientProperties{
+				AccountSid: properties.Sid,
+				Sid:        addressSid,
+			})
+		},
+		IncomingPhoneNumber: func(incomingPhoneNumberSid string) *incoming_phone_number.Client {
+			return incoming_phone_number.New(client, incoming_phone_number.ClientProperties{
+				AccountSid: properties.Sid,
+				Sid:        incomingPhoneNumberSid,
+			})
+		},
+		OutgoingCallerID: func(outgoingCallerIDSid string) *outgoing_caller_id.Client {
+			return outgoing_caller_id.New(client, outgoing_caller_id.ClientProperties{
+				AccountSid: properties.Sid,
+				Sid:        outgoingCallerIDSid,
+			})
+		},
+		OutgoingCallerIDs: outgoing_caller_ids.New(client, outgoing_caller_ids.ClientProperties{
+			AccountSid: properties.Sid,
+		}),
+		ShortCode: func(shortCodeSid string) *short_code.Client {
+			return short_code.New(client, short_code.ClientProperties{
+				AccountSid: properties.Sid,
+				Sid:        shortCodeSid,
+			})
+		},
+		ShortCodes: short_codes.New(client, short_codes.ClientProperties{
+			AccountSid: properties.Sid,
+		}),
+		ValidationRequests: validation_requests.New(client, validation_requests.ClientProperties{
+			AccountSid: properties.Sid,
+		}),
+	}
+}
@@ -0,0 +1,36 @@
+package incoming_phone_number
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+// FetchEmergencyRegistrationResponse defines the emergency registration response fields for the retrieved phone number
+// The remaining phone number fields can be retrieved using the twilio-sdk-go
+type FetchEmergencyRegistrationResponse struct {
+	EmergencyAddressSid    *string `json:"emergency_address_sid,omitempty"`
+	EmergencyAddressStatus string  `json:"emergency_address_status"`
+	EmergencyStatus        string  `json:"emergency_status"`
+	Sid                    string  `json:"sid"`
+}
+
+// FetchEmergencyRegistrationWithContext retrieves the emergency registration details of a phone number resource
+// See https://www.twilio.com/docs/phone-numbers/api/incomingphonenumber-resource#fetch-an-incomingphonenumber-resource for more details
+func (c Client) FetchEmergencyRegistrationWithContext(context context.Context) (*FetchEmergencyRegistrationResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "/Accounts/{accountSid}/IncomingPhoneNumbers/{sid}.json",
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+			"sid":        c.sid,
+		},
+	}
+
+	response := &FetchEmergencyRegistrationResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
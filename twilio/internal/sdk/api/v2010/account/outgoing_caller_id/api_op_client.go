@@ -0,0 +1,29 @@
+// Package outgoing_caller_id contains the outgoing caller id API operations which are not currently supported by the twilio-sdk-go
+package outgoing_caller_id
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing a specific outgoing caller id resource
+// See https://www.twilio.com/docs/voice/api/outgoing-caller-ids for more details
+type Client struct {
+	client *client.Client
+
+	accountSid string
+	sid        string
+}
+
+// ClientProperties are the properties required to manage the outgoing caller id resources
+type ClientProperties struct {
+	AccountSid string
+	Sid        string
+}
+
+// New creates a new instance of the outgoing caller id client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		accountSid: properties.AccountSid,
+		sid:        properties.Sid,
+	}
+}
@@ -0,0 +1,26 @@
+package outgoing_caller_id
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+// DeleteWithContext removes an outgoing caller id resource from the account
+// See https://www.twilio.com/docs/voice/api/outgoing-caller-ids#delete-an-outgoingcallerid-resource for more details
+func (c Client) DeleteWithContext(context context.Context) error {
+	op := client.Operation{
+		Method: http.MethodDelete,
+		URI:    "/Accounts/{accountSid}/OutgoingCallerIds/{sid}.json",
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+			"sid":        c.sid,
+		},
+	}
+
+	if err := c.client.Send(context, op, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package outgoing_caller_id
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+// FetchOutgoingCallerIDResponse defines the response fields for the retrieved outgoing caller id
+type FetchOutgoingCallerIDResponse struct {
+	AccountSid   string             `json:"account_sid"`
+	DateCreated  utils.RFC2822Time  `json:"date_created"`
+	DateUpdated  *utils.RFC2822Time `json:"date_updated,omitempty"`
+	FriendlyName string             `json:"friendly_name"`
+	PhoneNumber  string             `json:"phone_number"`
+	Sid          string             `json:"sid"`
+}
+
+// FetchWithContext retrieves an outgoing caller id resource
+// See https://www.twilio.com/docs/voice/api/outgoing-caller-ids#fetch-an-outgoingcallerid-resource for more details
+func (c Client) FetchWithContext(context context.Context) (*FetchOutgoingCallerIDResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "/Accounts/{accountSid}/OutgoingCallerIds/{sid}.json",
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+			"sid":        c.sid,
+		},
+	}
+
+	response := &FetchOutgoingCallerIDResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
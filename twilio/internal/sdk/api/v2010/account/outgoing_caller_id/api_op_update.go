@@ -0,0 +1,48 @@
+package outgoing_caller_id
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+// UpdateOutgoingCallerIDInput defines input fields for updating an outgoing caller id
+type UpdateOutgoingCallerIDInput struct {
+	FriendlyName *string `form:"FriendlyName,omitempty"`
+}
+
+// UpdateOutgoingCallerIDResponse defines the response fields for the updated outgoing caller id
+type UpdateOutgoingCallerIDResponse struct {
+	AccountSid   string             `json:"account_sid"`
+	DateCreated  utils.RFC2822Time  `json:"date_created"`
+	DateUpdated  *utils.RFC2822Time `json:"date_updated,omitempty"`
+	FriendlyName string             `json:"friendly_name"`
+	PhoneNumber  string             `json:"phone_number"`
+	Sid          string             `json:"sid"`
+}
+
+// UpdateWithContext modifies an outgoing caller id resource
+// See https://www.twilio.com/docs/voice/api/outgoing-caller-ids#update-an-outgoingcallerid-resource for more details
+func (c Client) UpdateWithContext(context context.Context, input *UpdateOutgoingCallerIDInput) (*UpdateOutgoingCallerIDResponse, error) {
+	op := client.Operation{
+		Method:      http.MethodPost,
+		URI:         "/Accounts/{accountSid}/OutgoingCallerIds/{sid}.json",
+		ContentType: client.URLEncoded,
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+			"sid":        c.sid,
+		},
+	}
+
+	if input == nil {
+		input = &UpdateOutgoingCallerIDInput{}
+	}
+
+	response := &UpdateOutgoingCallerIDResponse{}
+	if err := c.client.Send(context, op, input, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,26 @@
+// Package outgoing_caller_ids contains the outgoing caller id API operations which are not currently supported by the twilio-sdk-go
+package outgoing_caller_ids
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing outgoing caller id resources
+// See https://www.twilio.com/docs/voice/api/outgoing-caller-ids for more details
+type Client struct {
+	client *client.Client
+
+	accountSid string
+}
+
+// ClientProperties are the properties required to manage the outgoing caller id resources
+type ClientProperties struct {
+	AccountSid string
+}
+
+// New creates a new instance of the outgoing caller ids client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		accountSid: properties.AccountSid,
+	}
+}
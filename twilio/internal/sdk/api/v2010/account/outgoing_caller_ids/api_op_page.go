@@ -0,0 +1,136 @@
+package outgoing_caller_ids
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+// OutgoingCallerIDsPageOptions defines the query options for the api operation
+type OutgoingCallerIDsPageOptions struct {
+	PageSize     *int
+	Page         *int
+	PageToken    *string
+	FriendlyName *string
+	PhoneNumber  *string
+}
+
+type PageOutgoingCallerIDResponse struct {
+	AccountSid   string             `json:"account_sid"`
+	DateCreated  utils.RFC2822Time  `json:"date_created"`
+	DateUpdated  *utils.RFC2822Time `json:"date_updated,omitempty"`
+	FriendlyName string             `json:"friendly_name"`
+	PhoneNumber  string             `json:"phone_number"`
+	Sid          string             `json:"sid"`
+}
+
+// OutgoingCallerIDsPageResponse defines the response fields for the outgoing caller ids page
+type OutgoingCallerIDsPageResponse struct {
+	End               int                            `json:"end"`
+	FirstPageURI      string                         `json:"first_page_uri"`
+	NextPageURI       *string                        `json:"next_page_uri,omitempty"`
+	OutgoingCallerIDs []PageOutgoingCallerIDResponse `json:"outgoing_caller_ids"`
+	Page              int                            `json:"page"`
+	PageSize          int                            `json:"page_size"`
+	PreviousPageURI   *string                        `json:"previous_page_uri,omitempty"`
+	Start             int                            `json:"start"`
+	URI               string                         `json:"uri"`
+}
+
+// PageWithContext retrieves a page of outgoing caller ids
+// See https://www.twilio.com/docs/voice/api/outgoing-caller-ids#read-multiple-outgoingcallerid-resources for more details
+func (c Client) PageWithContext(context context.Context, options *OutgoingCallerIDsPageOptions) (*OutgoingCallerIDsPageResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "/Accounts/{accountSid}/SMS/OutgoingCallerIDs.json",
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+		},
+		QueryParams: utils.StructToURLValues(options),
+	}
+
+	response := &OutgoingCallerIDsPageResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// OutgoingCallerIDsPaginator defines the fields for makings paginated api calls
+// OutgoingCallerIDs is an array of outgoing caller ids that have been returned from all of the page calls
+type OutgoingCallerIDsPaginator struct {
+	client  *Client
+	options *OutgoingCallerIDsPageOptions
+
+	CurrentPage       *OutgoingCallerIDsPageResponse
+	OutgoingCallerIDs []PageOutgoingCallerIDResponse
+	Error             error
+}
+
+// NewOutgoingCallerIDsPaginator creates a new instance of the paginator for Page.
+func (c *Client) NewOutgoingCallerIDsPaginator() *OutgoingCallerIDsPaginator {
+	return c.NewOutgoingCallerIDsPaginatorWithOptions(nil)
+}
+
+// NewOutgoingCallerIDsPaginatorWithOptions creates a new instance of the paginator for Page with options.
+func (c *Client) NewOutgoingCallerIDsPaginatorWithOptions(options *OutgoingCallerIDsPageOptions) *OutgoingCallerIDsPaginator {
+	return &OutgoingCallerIDsPaginator{
+		client:            c,
+		options:           options,
+		OutgoingCallerIDs: make([]PageOutgoingCallerIDResponse, 0),
+	}
+}
+
+// NextWithContext retrieves the next page of results.
+// NextWithContext will return false when either an error occurs or there are no more pages to iterate
+func (p *OutgoingCallerIDsPaginator) NextWithContext(context context.Context) bool {
+	options := p.options
+
+	if options == nil {
+		options = &OutgoingCallerIDsPageOptions{}
+	}
+
+	if p.CurrentPage != nil {
+		nextPage := p.CurrentPage.NextPageURI
+
+		if nextPage == nil {
+			return false
+		}
+
+		parsedURL, err := url.Parse(*nextPage)
+		if err != nil {
+			p.Error = err
+			return false
+		}
+
+		options.PageToken = utils.String(parsedURL.Query().Get("PageToken"))
+
+		page, pageErr := strconv.Atoi(parsedURL.Query().Get("Page"))
+		if pageErr != nil {
+			p.Error = pageErr
+			return false
+		}
+		options.Page = utils.Int(page)
+
+		pageSize, pageSizeErr := strconv.Atoi(parsedURL.Query().Get("PageSize"))
+		if pageSizeErr != nil {
+			p.Error = pageSizeErr
+			return false
+		}
+		options.PageSize = utils.Int(pageSize)
+	}
+
+	resp, err := p.client.PageWithContext(context, options)
+	p.CurrentPage = resp
+	p.Error = err
+
+	if p.Error == nil {
+		p.OutgoingCallerIDs = append(p.OutgoingCallerIDs, resp.OutgoingCallerIDs...)
+	}
+
+	return p.Error == nil
+}
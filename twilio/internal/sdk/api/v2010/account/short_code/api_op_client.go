@@ -0,0 +1,29 @@
+// Package short_code contains the short code API operations which are not currently supported by the twilio-sdk-go
+package short_code
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing a specific short code resource
+// See https://www.twilio.com/docs/sms/api/short-code for more details
+type Client struct {
+	client *client.Client
+
+	accountSid string
+	sid        string
+}
+
+// ClientProperties are the properties required to manage the short code resources
+type ClientProperties struct {
+	AccountSid string
+	Sid        string
+}
+
+// New creates a new instance of the short code client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		accountSid: properties.AccountSid,
+		sid:        properties.Sid,
+	}
+}
@@ -0,0 +1,43 @@
+package short_code
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+// FetchShortCodeResponse defines the response fields for the retrieved short code
+type FetchShortCodeResponse struct {
+	AccountSid        string             `json:"account_sid"`
+	APIVersion        string             `json:"api_version"`
+	DateCreated       utils.RFC2822Time  `json:"date_created"`
+	DateUpdated       *utils.RFC2822Time `json:"date_updated,omitempty"`
+	FriendlyName      string             `json:"friendly_name"`
+	ShortCode         string             `json:"short_code"`
+	Sid               string             `json:"sid"`
+	SmsFallbackMethod string             `json:"sms_fallback_method"`
+	SmsFallbackURL    *string            `json:"sms_fallback_url,omitempty"`
+	SmsMethod         string             `json:"sms_method"`
+	SmsURL            *string            `json:"sms_url,omitempty"`
+}
+
+// FetchWithContext retrieves a short code resource
+// See https://www.twilio.com/docs/sms/api/short-code#fetch-a-shortcode-resource for more details
+func (c Client) FetchWithContext(context context.Context) (*FetchShortCodeResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "/Accounts/{accountSid}/SMS/ShortCodes/{sid}.json",
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+			"sid":        c.sid,
+		},
+	}
+
+	response := &FetchShortCodeResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,58 @@
+package short_code
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+// UpdateShortCodeInput defines input fields for updating a short code
+type UpdateShortCodeInput struct {
+	APIVersion        *string `form:"ApiVersion,omitempty"`
+	FriendlyName      *string `form:"FriendlyName,omitempty"`
+	SmsFallbackMethod *string `form:"SmsFallbackMethod,omitempty"`
+	SmsFallbackURL    *string `form:"SmsFallbackUrl,omitempty"`
+	SmsMethod         *string `form:"SmsMethod,omitempty"`
+	SmsURL            *string `form:"SmsUrl,omitempty"`
+}
+
+// UpdateShortCodeResponse defines the response fields for the updated short code
+type UpdateShortCodeResponse struct {
+	AccountSid        string             `json:"account_sid"`
+	APIVersion        string             `json:"api_version"`
+	DateCreated       utils.RFC2822Time  `json:"date_created"`
+	DateUpdated       *utils.RFC2822Time `json:"date_updated,omitempty"`
+	FriendlyName      string             `json:"friendly_name"`
+	ShortCode         string             `json:"short_code"`
+	Sid               string             `json:"sid"`
+	SmsFallbackMethod string             `json:"sms_fallback_method"`
+	SmsFallbackURL    *string            `json:"sms_fallback_url,omitempty"`
+	SmsMethod         string             `json:"sms_method"`
+	SmsURL            *string            `json:"sms_url,omitempty"`
+}
+
+// UpdateWithContext modifies a short code resource
+// See https://www.twilio.com/docs/sms/api/short-code#update-a-shortcode-resource for more details
+func (c Client) UpdateWithContext(context context.Context, input *UpdateShortCodeInput) (*UpdateShortCodeResponse, error) {
+	op := client.Operation{
+		Method:      http.MethodPost,
+		URI:         "/Accounts/{accountSid}/SMS/ShortCodes/{sid}.json",
+		ContentType: client.URLEncoded,
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+			"sid":        c.sid,
+		},
+	}
+
+	if input == nil {
+		input = &UpdateShortCodeInput{}
+	}
+
+	response := &UpdateShortCodeResponse{}
+	if err := c.client.Send(context, op, input, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
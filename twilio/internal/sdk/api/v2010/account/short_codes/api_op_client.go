@@ -0,0 +1,26 @@
+// Package short_codes contains the short code API operations which are not currently supported by the twilio-sdk-go
+package short_codes
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing short code resources
+// See https://www.twilio.com/docs/sms/api/short-code for more details
+type Client struct {
+	client *client.Client
+
+	accountSid string
+}
+
+// ClientProperties are the properties required to manage the short code resources
+type ClientProperties struct {
+	AccountSid string
+}
+
+// New creates a new instance of the short codes client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		accountSid: properties.AccountSid,
+	}
+}
@@ -0,0 +1,141 @@
+package short_codes
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+// ShortCodesPageOptions defines the query options for the api operation
+type ShortCodesPageOptions struct {
+	PageSize     *int
+	Page         *int
+	PageToken    *string
+	FriendlyName *string
+	ShortCode    *string
+}
+
+type PageShortCodeResponse struct {
+	AccountSid        string             `json:"account_sid"`
+	APIVersion        string             `json:"api_version"`
+	DateCreated       utils.RFC2822Time  `json:"date_created"`
+	DateUpdated       *utils.RFC2822Time `json:"date_updated,omitempty"`
+	FriendlyName      string             `json:"friendly_name"`
+	ShortCode         string             `json:"short_code"`
+	Sid               string             `json:"sid"`
+	SmsFallbackMethod string             `json:"sms_fallback_method"`
+	SmsFallbackURL    *string            `json:"sms_fallback_url,omitempty"`
+	SmsMethod         string             `json:"sms_method"`
+	SmsURL            *string            `json:"sms_url,omitempty"`
+}
+
+// ShortCodesPageResponse defines the response fields for the short codes page
+type ShortCodesPageResponse struct {
+	End             int                     `json:"end"`
+	FirstPageURI    string                  `json:"first_page_uri"`
+	NextPageURI     *string                 `json:"next_page_uri,omitempty"`
+	Page            int                     `json:"page"`
+	PageSize        int                     `json:"page_size"`
+	PreviousPageURI *string                 `json:"previous_page_uri,omitempty"`
+	ShortCodes      []PageShortCodeResponse `json:"short_codes"`
+	Start           int                     `json:"start"`
+	URI             string                  `json:"uri"`
+}
+
+// PageWithContext retrieves a page of short codes
+// See https://www.twilio.com/docs/sms/api/short-code#read-multiple-shortcode-resources for more details
+func (c Client) PageWithContext(context context.Context, options *ShortCodesPageOptions) (*ShortCodesPageResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "/Accounts/{accountSid}/SMS/ShortCodes.json",
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+		},
+		QueryParams: utils.StructToURLValues(options),
+	}
+
+	response := &ShortCodesPageResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ShortCodesPaginator defines the fields for makings paginated api calls
+// ShortCodes is an array of short codes that have been returned from all of the page calls
+type ShortCodesPaginator struct {
+	client  *Client
+	options *ShortCodesPageOptions
+
+	CurrentPage *ShortCodesPageResponse
+	ShortCodes  []PageShortCodeResponse
+	Error       error
+}
+
+// NewShortCodesPaginator creates a new instance of the paginator for Page.
+func (c *Client) NewShortCodesPaginator() *ShortCodesPaginator {
+	return c.NewShortCodesPaginatorWithOptions(nil)
+}
+
+// NewShortCodesPaginatorWithOptions creates a new instance of the paginator for Page with options.
+func (c *Client) NewShortCodesPaginatorWithOptions(options *ShortCodesPageOptions) *ShortCodesPaginator {
+	return &ShortCodesPaginator{
+		client:     c,
+		options:    options,
+		ShortCodes: make([]PageShortCodeResponse, 0),
+	}
+}
+
+// NextWithContext retrieves the next page of results.
+// NextWithContext will return false when either an error occurs or there are no more pages to iterate
+func (p *ShortCodesPaginator) NextWithContext(context context.Context) bool {
+	options := p.options
+
+	if options == nil {
+		options = &ShortCodesPageOptions{}
+	}
+
+	if p.CurrentPage != nil {
+		nextPage := p.CurrentPage.NextPageURI
+
+		if nextPage == nil {
+			return false
+		}
+
+		parsedURL, err := url.Parse(*nextPage)
+		if err != nil {
+			p.Error = err
+			return false
+		}
+
+		options.PageToken = utils.String(parsedURL.Query().Get("PageToken"))
+
+		page, pageErr := strconv.Atoi(parsedURL.Query().Get("Page"))
+		if pageErr != nil {
+			p.Error = pageErr
+			return false
+		}
+		options.Page = utils.Int(page)
+
+		pageSize, pageSizeErr := strconv.Atoi(parsedURL.Query().Get("PageSize"))
+		if pageSizeErr != nil {
+			p.Error = pageSizeErr
+			return false
+		}
+		options.PageSize = utils.Int(pageSize)
+	}
+
+	resp, err := p.client.PageWithContext(context, options)
+	p.CurrentPage = resp
+	p.Error = err
+
+	if p.Error == nil {
+		p.ShortCodes = append(p.ShortCodes, resp.ShortCodes...)
+	}
+
+	return p.Error == nil
+}
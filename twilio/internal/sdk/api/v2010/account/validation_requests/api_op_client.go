@@ -0,0 +1,26 @@
+// Package validation_requests contains the validation request API operations which are not currently supported by the twilio-sdk-go
+package validation_requests
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing validation request resources
+// See https://www.twilio.com/docs/voice/api/outgoing-caller-ids#add-an-outgoing-caller-id for more details
+type Client struct {
+	client *client.Client
+
+	accountSid string
+}
+
+// ClientProperties are the properties required to manage the validation request resources
+type ClientProperties struct {
+	AccountSid string
+}
+
+// New creates a new instance of the validation requests client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		accountSid: properties.AccountSid,
+	}
+}
@@ -0,0 +1,50 @@
+package validation_requests
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+// CreateValidationRequestInput defines input fields for creating a new validation request
+type CreateValidationRequestInput struct {
+	CallDelay            *int    `form:"CallDelay,omitempty"`
+	Extension            *string `form:"Extension,omitempty"`
+	FriendlyName         *string `form:"FriendlyName,omitempty"`
+	PhoneNumber          string  `validate:"required" form:"PhoneNumber"`
+	StatusCallback       *string `form:"StatusCallback,omitempty"`
+	StatusCallbackMethod *string `form:"StatusCallbackMethod,omitempty"`
+}
+
+// CreateValidationRequestResponse defines the response fields for creating a new validation request
+type CreateValidationRequestResponse struct {
+	AccountSid     string  `json:"account_sid"`
+	CallSid        string  `json:"call_sid"`
+	FriendlyName   *string `json:"friendly_name,omitempty"`
+	PhoneNumber    string  `json:"phone_number"`
+	ValidationCode string  `json:"validation_code"`
+}
+
+// CreateWithContext creates a new validation request resource
+// See https://www.twilio.com/docs/voice/api/outgoing-caller-ids#add-an-outgoing-caller-id for more details
+func (c Client) CreateWithContext(context context.Context, input *CreateValidationRequestInput) (*CreateValidationRequestResponse, error) {
+	op := client.Operation{
+		Method:      http.MethodPost,
+		URI:         "/Accounts/{accountSid}/OutgoingCallerIds.json",
+		ContentType: client.URLEncoded,
+		PathParams: map[string]string{
+			"accountSid": c.accountSid,
+		},
+	}
+
+	if input == nil {
+		input = &CreateValidationRequestInput{}
+	}
+
+	response := &CreateValidationRequestResponse{}
+	if err := c.client.Send(context, op, input, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,35 @@
+// Package v1 contains the Flex v1 API operations which are not currently supported by the twilio-sdk-go
+package v1
+
+import (
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/flex/v1/configuration"
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/session"
+)
+
+// Flex client is used to manage resources for Twilio Flex
+// See https://www.twilio.com/docs/flex for more details
+type Flex struct {
+	client *client.Client
+
+	Configuration func() *configuration.Client
+}
+
+// NewWithClient creates a new instance of the client with a HTTP client
+func NewWithClient(client *client.Client) *Flex {
+	return &Flex{
+		client: client,
+
+		Configuration: func() *configuration.Client { return configuration.New(client) },
+	}
+}
+
+// New creates a new instance of the client using session data and config
+func New(sess *session.Session, clientConfig *client.Config) *Flex {
+	config := client.NewAPIClientConfig(clientConfig)
+	config.Beta = false
+	config.SubDomain = "flex-api"
+	config.APIVersion = "v1"
+
+	return NewWithClient(client.New(sess, config))
+}
@@ -0,0 +1,18 @@
+// Package configuration contains the flex configuration API operations which are not currently supported by the twilio-sdk-go.
+// The twilio-sdk-go configuration types do not match all of the JSON returned by the API, so the configuration sections are left as raw JSON values
+package configuration
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing the flex configuration resource
+// See https://www.twilio.com/docs/flex/developer/config/flex-configuration-rest-api for more details
+type Client struct {
+	client *client.Client
+}
+
+// New creates a new instance of the configuration client
+func New(client *client.Client) *Client {
+	return &Client{
+		client: client,
+	}
+}
@@ -0,0 +1,53 @@
+package configuration
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+// FetchConfigurationResponse defines the response fields for the retrieved flex configuration
+type FetchConfigurationResponse struct {
+	AccountSid                   string       `json:"account_sid"`
+	Attributes                   *interface{} `json:"attributes,omitempty"`
+	ChatServiceInstanceSid       *string      `json:"chat_service_instance_sid,omitempty"`
+	CrmAttributes                *interface{} `json:"crm_attributes,omitempty"`
+	CrmCallbackURL               *string      `json:"crm_callback_url,omitempty"`
+	CrmEnabled                   *bool        `json:"crm_enabled,omitempty"`
+	CrmFallbackURL               *string      `json:"crm_fallback_url,omitempty"`
+	CrmType                      *string      `json:"crm_type,omitempty"`
+	DateCreated                  time.Time    `json:"date_created"`
+	DateUpdated                  *time.Time   `json:"date_updated,omitempty"`
+	FlexServiceInstanceSid       *string      `json:"flex_service_instance_sid,omitempty"`
+	MessagingServiceInstanceSid  *string      `json:"messaging_service_instance_sid,omitempty"`
+	QueueStatsConfiguration      *interface{} `json:"queue_stats_configuration,omitempty"`
+	RuntimeDomain                *string      `json:"runtime_domain,omitempty"`
+	ServiceVersion               *string      `json:"service_version,omitempty"`
+	Status                       string       `json:"status"`
+	TaskRouterOfflineActivitySid *string      `json:"taskrouter_offline_activity_sid,omitempty"`
+	TaskRouterSkills             *interface{} `json:"taskrouter_skills,omitempty"`
+	TaskRouterTargetTaskQueueSid *string      `json:"taskrouter_target_taskqueue_sid,omitempty"`
+	TaskRouterTargetWorkflowSid  *string      `json:"taskrouter_target_workflow_sid,omitempty"`
+	TaskRouterWorkspaceSid       *string      `json:"taskrouter_workspace_sid,omitempty"`
+	UiAttributes                 *interface{} `json:"ui_attributes,omitempty"`
+	UiLanguage                   *string      `json:"ui_language,omitempty"`
+	UiVersion                    *string      `json:"ui_version,omitempty"`
+	URL                          string       `json:"url"`
+}
+
+// FetchWithContext retrieves the flex configuration resource
+// See https://www.twilio.com/docs/flex/developer/config/flex-configuration-rest-api#retrieve-the-flex-configuration for more details
+func (c Client) FetchWithContext(context context.Context) (*FetchConfigurationResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "/Configuration",
+	}
+
+	response := &FetchConfigurationResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
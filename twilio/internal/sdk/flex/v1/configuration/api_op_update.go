@@ -0,0 +1,45 @@
+package configuration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+// UpdateConfigurationInput defines input fields for updating the flex configuration resource. Only the fields which are set are sent to the API
+type UpdateConfigurationInput struct {
+	AccountSid                   string       `validate:"required" json:"account_sid"`
+	Attributes                   *interface{} `json:"attributes,omitempty"`
+	CrmAttributes                *interface{} `json:"crm_attributes,omitempty"`
+	CrmCallbackURL               *string      `json:"crm_callback_url,omitempty"`
+	CrmEnabled                   *bool        `json:"crm_enabled,omitempty"`
+	CrmFallbackURL               *string      `json:"crm_fallback_url,omitempty"`
+	CrmType                      *string      `json:"crm_type,omitempty"`
+	QueueStatsConfiguration      *interface{} `json:"queue_stats_configuration,omitempty"`
+	TaskRouterOfflineActivitySid *string      `json:"taskrouter_offline_activity_sid,omitempty"`
+	TaskRouterSkills             *interface{} `json:"taskrouter_skills,omitempty"`
+	TaskRouterTargetTaskQueueSid *string      `json:"taskrouter_target_taskqueue_sid,omitempty"`
+	TaskRouterTargetWorkflowSid  *string      `json:"taskrouter_target_workflow_sid,omitempty"`
+	UiAttributes                 *interface{} `json:"ui_attributes,omitempty"`
+}
+
+// UpdateWithContext modifies the flex configuration resource
+// See https://www.twilio.com/docs/flex/developer/config/flex-configuration-rest-api#update-the-flex-configuration for more details
+func (c Client) UpdateWithContext(context context.Context, input *UpdateConfigurationInput) (*FetchConfigurationResponse, error) {
+	op := client.Operation{
+		Method:      http.MethodPost,
+		URI:         "/Configuration",
+		ContentType: client.JSON,
+	}
+
+	if input == nil {
+		input = &UpdateConfigurationInput{}
+	}
+
+	response := &FetchConfigurationResponse{}
+	if err := c.client.Send(context, op, input, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,42 @@
+// Package v1 contains the Numbers v1 API operations which are not currently supported by the twilio-sdk-go
+package v1
+
+import (
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v1/port_in"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v1/port_ins"
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/session"
+)
+
+// Numbers client is used to manage resources for Twilio Numbers
+// See https://www.twilio.com/docs/phone-numbers for more details
+type Numbers struct {
+	client *client.Client
+
+	PortIns *port_ins.Client
+	PortIn  func(string) *port_in.Client
+}
+
+// NewWithClient creates a new instance of the client with a HTTP client
+func NewWithClient(client *client.Client) *Numbers {
+	return &Numbers{
+		client: client,
+
+		PortIns: port_ins.New(client),
+		PortIn: func(portInRequestSid string) *port_in.Client {
+			return port_in.New(client, port_in.ClientProperties{
+				Sid: portInRequestSid,
+			})
+		},
+	}
+}
+
+// New creates a new instance of the client using session data and config
+func New(sess *session.Session, clientConfig *client.Config) *Numbers {
+	config := client.NewAPIClientConfig(clientConfig)
+	config.Beta = true
+	config.SubDomain = "numbers"
+	config.APIVersion = "v1"
+
+	return NewWithClient(client.New(sess, config))
+}
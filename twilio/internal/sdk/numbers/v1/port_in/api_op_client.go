@@ -0,0 +1,26 @@
+// Package port_in contains the port in request API operations which are not currently supported by the twilio-sdk-go
+package port_in
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing a specific port in request resource
+// See https://www.twilio.com/docs/phone-numbers/port-in/port-in-request-api for more details
+type Client struct {
+	client *client.Client
+
+	sid string
+}
+
+// ClientProperties are the properties required to manage the port in request resources
+type ClientProperties struct {
+	Sid string
+}
+
+// New creates a new instance of the port in request client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		sid: properties.Sid,
+	}
+}
@@ -0,0 +1,25 @@
+package port_in
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+// DeleteWithContext cancels a port in request resource
+// See https://www.twilio.com/docs/phone-numbers/port-in/port-in-request-api#cancel-a-port-in-request for more details
+func (c Client) DeleteWithContext(context context.Context) error {
+	op := client.Operation{
+		Method: http.MethodDelete,
+		URI:    "/Porting/PortIn/{sid}",
+		PathParams: map[string]string{
+			"sid": c.sid,
+		},
+	}
+
+	if err := c.client.Send(context, op, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
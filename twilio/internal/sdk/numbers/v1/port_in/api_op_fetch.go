@@ -0,0 +1,71 @@
+package port_in
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+type FetchPortInLosingCarrierInformationAddressResponse struct {
+	City    string  `json:"city"`
+	Country string  `json:"country"`
+	State   string  `json:"state"`
+	Street  string  `json:"street"`
+	Street2 *string `json:"street_2,omitempty"`
+	Zip     string  `json:"zip"`
+}
+
+type FetchPortInLosingCarrierInformationResponse struct {
+	AccountNumber                 *string                                            `json:"account_number,omitempty"`
+	AccountTelephoneNumber        *string                                            `json:"account_telephone_number,omitempty"`
+	Address                       FetchPortInLosingCarrierInformationAddressResponse `json:"address"`
+	AuthorizedRepresentative      string                                             `json:"authorized_representative"`
+	AuthorizedRepresentativeEmail string                                             `json:"authorized_representative_email"`
+	CustomerName                  string                                             `json:"customer_name"`
+	CustomerType                  string                                             `json:"customer_type"`
+}
+
+type FetchPortInPhoneNumberResponse struct {
+	PhoneNumber             string  `json:"phone_number"`
+	PortInPhoneNumberSid    string  `json:"port_in_phone_number_sid"`
+	PortInPhoneNumberStatus string  `json:"port_in_phone_number_status"`
+	RejectionReason         *string `json:"rejection_reason,omitempty"`
+}
+
+// FetchPortInResponse defines the response fields for the retrieved port in request
+type FetchPortInResponse struct {
+	AccountSid                 string                                      `json:"account_sid"`
+	BundleSid                  *string                                     `json:"bundle_sid,omitempty"`
+	DateCreated                *time.Time                                  `json:"date_created,omitempty"`
+	Documents                  []string                                    `json:"documents"`
+	LosingCarrierInformation   FetchPortInLosingCarrierInformationResponse `json:"losing_carrier_information"`
+	NotificationEmails         []string                                    `json:"notification_emails"`
+	PhoneNumbers               []FetchPortInPhoneNumberResponse            `json:"phone_numbers"`
+	PortInRequestSid           string                                      `json:"port_in_request_sid"`
+	PortInRequestStatus        string                                      `json:"port_in_request_status"`
+	SignatureRequestURL        *string                                     `json:"signature_request_url,omitempty"`
+	TargetPortInDate           *string                                     `json:"target_port_in_date,omitempty"`
+	TargetPortInTimeRangeEnd   *string                                     `json:"target_port_in_time_range_end,omitempty"`
+	TargetPortInTimeRangeStart *string                                     `json:"target_port_in_time_range_start,omitempty"`
+	URL                        string                                      `json:"url"`
+}
+
+// FetchWithContext retrieves a port in request resource
+// See https://www.twilio.com/docs/phone-numbers/port-in/port-in-request-api#fetch-a-port-in-request for more details
+func (c Client) FetchWithContext(context context.Context) (*FetchPortInResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "/Porting/PortIn/{sid}",
+		PathParams: map[string]string{
+			"sid": c.sid,
+		},
+	}
+
+	response := &FetchPortInResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
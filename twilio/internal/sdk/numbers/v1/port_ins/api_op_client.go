@@ -0,0 +1,17 @@
+// Package port_ins contains the port in request API operations which are not currently supported by the twilio-sdk-go
+package port_ins
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing port in request resources
+// See https://www.twilio.com/docs/phone-numbers/port-in/port-in-request-api for more details
+type Client struct {
+	client *client.Client
+}
+
+// New creates a new instance of the port in requests client
+func New(client *client.Client) *Client {
+	return &Client{
+		client: client,
+	}
+}
@@ -0,0 +1,74 @@
+package port_ins
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+type CreatePortInLosingCarrierInformationAddressInput struct {
+	City    string  `validate:"required" json:"city"`
+	Country string  `validate:"required" json:"country"`
+	State   string  `validate:"required" json:"state"`
+	Street  string  `validate:"required" json:"street"`
+	Street2 *string `json:"street_2,omitempty"`
+	Zip     string  `validate:"required" json:"zip"`
+}
+
+type CreatePortInLosingCarrierInformationInput struct {
+	AccountNumber                 *string                                          `json:"account_number,omitempty"`
+	AccountTelephoneNumber        *string                                          `json:"account_telephone_number,omitempty"`
+	Address                       CreatePortInLosingCarrierInformationAddressInput `json:"address"`
+	AuthorizedRepresentative      string                                           `validate:"required" json:"authorized_representative"`
+	AuthorizedRepresentativeEmail string                                           `validate:"required" json:"authorized_representative_email"`
+	CustomerName                  string                                           `validate:"required" json:"customer_name"`
+	CustomerType                  string                                           `validate:"required" json:"customer_type"`
+}
+
+type CreatePortInPhoneNumberInput struct {
+	PhoneNumber string  `validate:"required" json:"phone_number"`
+	Pin         *string `json:"pin,omitempty"`
+}
+
+// CreatePortInInput defines input fields for creating a new port in request
+type CreatePortInInput struct {
+	AccountSid                 string                                    `validate:"required" json:"account_sid"`
+	BundleSid                  *string                                   `json:"bundle_sid,omitempty"`
+	Documents                  []string                                  `validate:"required" json:"documents"`
+	LosingCarrierInformation   CreatePortInLosingCarrierInformationInput `json:"losing_carrier_information"`
+	NotificationEmails         *[]string                                 `json:"notification_emails,omitempty"`
+	PhoneNumbers               []CreatePortInPhoneNumberInput            `validate:"required" json:"phone_numbers"`
+	TargetPortInDate           *string                                   `json:"target_port_in_date,omitempty"`
+	TargetPortInTimeRangeEnd   *string                                   `json:"target_port_in_time_range_end,omitempty"`
+	TargetPortInTimeRangeStart *string                                   `json:"target_port_in_time_range_start,omitempty"`
+}
+
+// CreatePortInResponse defines the response fields for creating a new port in request
+// The remaining port in request fields can be retrieved by fetching the port in request
+type CreatePortInResponse struct {
+	AccountSid          string `json:"account_sid"`
+	PortInRequestSid    string `json:"port_in_request_sid"`
+	PortInRequestStatus string `json:"port_in_request_status"`
+	URL                 string `json:"url"`
+}
+
+// CreateWithContext creates a new port in request resource
+// See https://www.twilio.com/docs/phone-numbers/port-in/port-in-request-api#create-a-port-in-request for more details
+func (c Client) CreateWithContext(context context.Context, input *CreatePortInInput) (*CreatePortInResponse, error) {
+	op := client.Operation{
+		Method:      http.MethodPost,
+		URI:         "/Porting/PortIn",
+		ContentType: client.JSON,
+	}
+
+	if input == nil {
+		input = &CreatePortInInput{}
+	}
+
+	response := &CreatePortInResponse{}
+	if err := c.client.Send(context, op, input, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
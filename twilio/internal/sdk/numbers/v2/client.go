@@ -0,0 +1,45 @@
+// Package v2 contains the Numbers v2 API operations which are not currently supported by the twilio-sdk-go
+package v2
+
+import (
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v2/hosted_number_order"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v2/hosted_number_orders"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v2/regulations"
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/session"
+)
+
+// Numbers client is used to manage resources for Twilio Numbers
+// See https://www.twilio.com/docs/phone-numbers for more details
+type Numbers struct {
+	client *client.Client
+
+	HostedNumberOrders *hosted_number_orders.Client
+	HostedNumberOrder  func(string) *hosted_number_order.Client
+	Regulations        *regulations.Client
+}
+
+// NewWithClient creates a new instance of the client with a HTTP client
+func NewWithClient(client *client.Client) *Numbers {
+	return &Numbers{
+		client: client,
+
+		HostedNumberOrders: hosted_number_orders.New(client),
+		HostedNumberOrder: func(hostedNumberOrderSid string) *hosted_number_order.Client {
+			return hosted_number_order.New(client, hosted_number_order.ClientProperties{
+				Sid: hostedNumberOrderSid,
+			})
+		},
+		Regulations: regulations.New(client),
+	}
+}
+
+// New creates a new instance of the client using session data and config
+func New(sess *session.Session, clientConfig *client.Config) *Numbers {
+	config := client.NewAPIClientConfig(clientConfig)
+	config.Beta = false
+	config.SubDomain = "numbers"
+	config.APIVersion = "v2"
+
+	return NewWithClient(client.New(sess, config))
+}
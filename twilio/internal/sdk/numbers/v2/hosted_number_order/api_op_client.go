@@ -0,0 +1,26 @@
+// Package hosted_number_order contains the hosted number order API operations which are not currently supported by the twilio-sdk-go
+package hosted_number_order
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing a specific hosted number order resource
+// See https://www.twilio.com/docs/phone-numbers/hosted-numbers/hosted-numbers-api/hosted-number-order-resource for more details
+type Client struct {
+	client *client.Client
+
+	sid string
+}
+
+// ClientProperties are the properties required to manage the hosted number order resources
+type ClientProperties struct {
+	Sid string
+}
+
+// New creates a new instance of the hosted number order client
+func New(client *client.Client, properties ClientProperties) *Client {
+	return &Client{
+		client: client,
+
+		sid: properties.Sid,
+	}
+}
@@ -0,0 +1,54 @@
+package hosted_number_order
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+type FetchHostedNumberOrderCapabilitiesResponse struct {
+	Mms   bool `json:"mms"`
+	Sms   bool `json:"sms"`
+	Voice bool `json:"voice"`
+}
+
+// FetchHostedNumberOrderResponse defines the response fields for the retrieved hosted number order
+type FetchHostedNumberOrderResponse struct {
+	AccountSid             string                                     `json:"account_sid"`
+	AddressSid             string                                     `json:"address_sid"`
+	Capabilities           FetchHostedNumberOrderCapabilitiesResponse `json:"capabilities"`
+	CcEmails               []string                                   `json:"cc_emails"`
+	ContactPhoneNumber     string                                     `json:"contact_phone_number"`
+	ContactTitle           *string                                    `json:"contact_title,omitempty"`
+	DateCreated            time.Time                                  `json:"date_created"`
+	DateUpdated            *time.Time                                 `json:"date_updated,omitempty"`
+	Email                  string                                     `json:"email"`
+	FailureReason          *string                                    `json:"failure_reason,omitempty"`
+	FriendlyName           *string                                    `json:"friendly_name,omitempty"`
+	IncomingPhoneNumberSid *string                                    `json:"incoming_phone_number_sid,omitempty"`
+	PhoneNumber            string                                     `json:"phone_number"`
+	Sid                    string                                     `json:"sid"`
+	SigningDocumentSid     *string                                    `json:"signing_document_sid,omitempty"`
+	Status                 string                                     `json:"status"`
+	URL                    string                                     `json:"url"`
+}
+
+// FetchWithContext retrieves a hosted number order resource
+// See https://www.twilio.com/docs/phone-numbers/hosted-numbers/hosted-numbers-api/hosted-number-order-resource#fetch-a-hostednumberorder-resource for more details
+func (c Client) FetchWithContext(context context.Context) (*FetchHostedNumberOrderResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "/HostedNumber/Orders/{sid}",
+		PathParams: map[string]string{
+			"sid": c.sid,
+		},
+	}
+
+	response := &FetchHostedNumberOrderResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
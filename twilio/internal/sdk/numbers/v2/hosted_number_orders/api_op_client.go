@@ -0,0 +1,17 @@
+// Package hosted_number_orders contains the hosted number order API operations which are not currently supported by the twilio-sdk-go
+package hosted_number_orders
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing hosted number order resources
+// See https://www.twilio.com/docs/phone-numbers/hosted-numbers/hosted-numbers-api/hosted-number-order-resource for more details
+type Client struct {
+	client *client.Client
+}
+
+// New creates a new instance of the hosted number orders client
+func New(client *client.Client) *Client {
+	return &Client{
+		client: client,
+	}
+}
@@ -0,0 +1,58 @@
+package hosted_number_orders
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+)
+
+// CreateHostedNumberOrderInput defines input fields for creating a new hosted number order
+type CreateHostedNumberOrderInput struct {
+	AccountSid           *string   `form:"AccountSid,omitempty"`
+	AddressSid           string    `validate:"required" form:"AddressSid"`
+	CcEmails             *[]string `form:"CcEmails,omitempty"`
+	ContactPhoneNumber   string    `validate:"required" form:"ContactPhoneNumber"`
+	ContactTitle         *string   `form:"ContactTitle,omitempty"`
+	Email                string    `validate:"required" form:"Email"`
+	FriendlyName         *string   `form:"FriendlyName,omitempty"`
+	PhoneNumber          string    `validate:"required" form:"PhoneNumber"`
+	SmsApplicationSid    *string   `form:"SmsApplicationSid,omitempty"`
+	SmsCapability        *bool     `form:"SmsCapability,omitempty"`
+	SmsFallbackMethod    *string   `form:"SmsFallbackMethod,omitempty"`
+	SmsFallbackURL       *string   `form:"SmsFallbackUrl,omitempty"`
+	SmsMethod            *string   `form:"SmsMethod,omitempty"`
+	SmsURL               *string   `form:"SmsUrl,omitempty"`
+	StatusCallbackMethod *string   `form:"StatusCallbackMethod,omitempty"`
+	StatusCallbackURL    *string   `form:"StatusCallbackUrl,omitempty"`
+}
+
+// CreateHostedNumberOrderResponse defines the response fields for creating a new hosted number order
+// The remaining hosted number order fields can be retrieved by fetching the hosted number order
+type CreateHostedNumberOrderResponse struct {
+	AccountSid  string `json:"account_sid"`
+	PhoneNumber string `json:"phone_number"`
+	Sid         string `json:"sid"`
+	Status      string `json:"status"`
+	URL         string `json:"url"`
+}
+
+// CreateWithContext creates a new hosted number order resource
+// See https://www.twilio.com/docs/phone-numbers/hosted-numbers/hosted-numbers-api/hosted-number-order-resource#create-a-hostednumberorder-resource for more details
+func (c Client) CreateWithContext(context context.Context, input *CreateHostedNumberOrderInput) (*CreateHostedNumberOrderResponse, error) {
+	op := client.Operation{
+		Method:      http.MethodPost,
+		URI:         "/HostedNumber/Orders",
+		ContentType: client.URLEncoded,
+	}
+
+	if input == nil {
+		input = &CreateHostedNumberOrderInput{}
+	}
+
+	response := &CreateHostedNumberOrderResponse{}
+	if err := c.client.Send(context, op, input, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
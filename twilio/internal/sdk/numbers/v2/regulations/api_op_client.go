@@ -0,0 +1,17 @@
+// Package regulations contains the regulation API operations which are not currently supported by the twilio-sdk-go
+package regulations
+
+import "github.com/RJPearson94/twilio-sdk-go/client"
+
+// Client for managing regulation resources
+// See https://www.twilio.com/docs/phone-numbers/regulatory/api/regulations for more details
+type Client struct {
+	client *client.Client
+}
+
+// New creates a new instance of the regulations client
+func New(client *client.Client) *Client {
+	return &Client{
+		client: client,
+	}
+}
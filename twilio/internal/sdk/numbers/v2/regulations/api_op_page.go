@@ -0,0 +1,161 @@
+package regulations
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/RJPearson94/twilio-sdk-go/client"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+// RegulationsPageOptions defines the query options for the api operation
+type RegulationsPageOptions struct {
+	PageSize    *int
+	Page        *int
+	PageToken   *string
+	EndUserType *string
+	IsoCountry  *string
+	NumberType  *string
+}
+
+type PageMetaResponse struct {
+	FirstPageURL    string  `json:"first_page_url"`
+	Key             string  `json:"key"`
+	NextPageURL     *string `json:"next_page_url,omitempty"`
+	Page            int     `json:"page"`
+	PageSize        int     `json:"page_size"`
+	PreviousPageURL *string `json:"previous_page_url,omitempty"`
+	URL             string  `json:"url"`
+}
+
+type PageRegulationEndUserRequirementResponse struct {
+	Fields          []string `json:"fields"`
+	Name            string   `json:"name"`
+	RequirementName string   `json:"requirement_name"`
+	Type            string   `json:"type"`
+	URL             *string  `json:"url,omitempty"`
+}
+
+type PageRegulationAcceptedDocumentResponse struct {
+	Fields []string `json:"fields"`
+	Name   string   `json:"name"`
+	Type   string   `json:"type"`
+	URL    *string  `json:"url,omitempty"`
+}
+
+type PageRegulationSupportingDocumentRequirementResponse struct {
+	AcceptedDocuments []PageRegulationAcceptedDocumentResponse `json:"accepted_documents"`
+	Description       *string                                  `json:"description,omitempty"`
+	Name              string                                   `json:"name"`
+	RequirementName   string                                   `json:"requirement_name"`
+	Type              string                                   `json:"type"`
+}
+
+type PageRegulationRequirementsResponse struct {
+	EndUser            []PageRegulationEndUserRequirementResponse              `json:"end_user"`
+	SupportingDocument [][]PageRegulationSupportingDocumentRequirementResponse `json:"supporting_document"`
+}
+
+type PageRegulationResponse struct {
+	EndUserType  string                             `json:"end_user_type"`
+	FriendlyName string                             `json:"friendly_name"`
+	IsoCountry   string                             `json:"iso_country"`
+	NumberType   string                             `json:"number_type"`
+	Requirements PageRegulationRequirementsResponse `json:"requirements"`
+	Sid          string                             `json:"sid"`
+	URL          string                             `json:"url"`
+}
+
+// RegulationsPageResponse defines the response fields for the regulations page
+type RegulationsPageResponse struct {
+	Meta        PageMetaResponse         `json:"meta"`
+	Regulations []PageRegulationResponse `json:"results"`
+}
+
+// PageWithContext retrieves a page of regulations
+// See https://www.twilio.com/docs/phone-numbers/regulatory/api/regulations#read-multiple-regulation-resources for more details
+func (c Client) PageWithContext(context context.Context, options *RegulationsPageOptions) (*RegulationsPageResponse, error) {
+	op := client.Operation{
+		Method:      http.MethodGet,
+		URI:         "/RegulatoryCompliance/Regulations",
+		QueryParams: utils.StructToURLValues(options),
+	}
+
+	response := &RegulationsPageResponse{}
+	if err := c.client.Send(context, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// RegulationsPaginator defines the fields for makings paginated api calls
+// Regulations is an array of regulations that have been returned from all of the page calls
+type RegulationsPaginator struct {
+	client  *Client
+	options *RegulationsPageOptions
+
+	CurrentPage *RegulationsPageResponse
+	Regulations []PageRegulationResponse
+	Error       error
+}
+
+// NewRegulationsPaginatorWithOptions creates a new instance of the paginator for Page with options.
+func (c *Client) NewRegulationsPaginatorWithOptions(options *RegulationsPageOptions) *RegulationsPaginator {
+	return &RegulationsPaginator{
+		client:      c,
+		options:     options,
+		Regulations: make([]PageRegulationResponse, 0),
+	}
+}
+
+// NextWithContext retrieves the next page of results.
+// NextWithContext will return false when either an error occurs or there are no more pages to iterate
+func (p *RegulationsPaginator) NextWithContext(context context.Context) bool {
+	options := p.options
+
+	if options == nil {
+		options = &RegulationsPageOptions{}
+	}
+
+	if p.CurrentPage != nil {
+		nextPage := p.CurrentPage.Meta.NextPageURL
+
+		if nextPage == nil {
+			return false
+		}
+
+		parsedURL, err := url.Parse(*nextPage)
+		if err != nil {
+			p.Error = err
+			return false
+		}
+
+		options.PageToken = utils.String(parsedURL.Query().Get("PageToken"))
+
+		page, pageErr := strconv.Atoi(parsedURL.Query().Get("Page"))
+		if pageErr != nil {
+			p.Error = pageErr
+			return false
+		}
+		options.Page = utils.Int(page)
+
+		pageSize, pageSizeErr := strconv.Atoi(parsedURL.Query().Get("PageSize"))
+		if pageSizeErr != nil {
+			p.Error = pageSizeErr
+			return false
+		}
+		options.PageSize = utils.Int(pageSize)
+	}
+
+	resp, err := p.client.PageWithContext(context, options)
+	p.CurrentPage = resp
+	p.Error = err
+
+	if p.Error == nil {
+		p.Regulations = append(p.Regulations, resp.Regulations...)
+	}
+
+	return p.Error == nil
+}
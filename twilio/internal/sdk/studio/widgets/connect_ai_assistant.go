@@ -0,0 +1,55 @@
+package widgets
+
+import (
+	"fmt"
+
+	"github.com/RJPearson94/twilio-sdk-go/studio/flow"
+	"github.com/RJPearson94/twilio-sdk-go/studio/properties"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+type ConnectAIAssistantNextTransitions struct {
+	Completed *string
+	Failed    *string
+}
+
+type ConnectAIAssistantProperties struct {
+	AssistantSid string             `validate:"required" json:"assistant_sid"`
+	Identity     *string            `json:"identity,omitempty"`
+	Offset       *properties.Offset `json:"offset,omitempty"`
+}
+
+type ConnectAIAssistant struct {
+	NextTransitions ConnectAIAssistantNextTransitions
+	Properties      ConnectAIAssistantProperties `validate:"required"`
+	Name            string                       `validate:"required"`
+}
+
+// Validate checks the widget is correctly configured
+func (widget ConnectAIAssistant) Validate() error {
+	if err := utils.ValidateInput(widget); err != nil {
+		return fmt.Errorf("Invalid input supplied. Errors %s", err.Error())
+	}
+	return nil
+}
+
+// ToState returns a populated Studio Widget State struct
+func (widget ConnectAIAssistant) ToState() (*flow.State, error) {
+	transitions := []flow.Transition{
+		{
+			Event: "completed",
+			Next:  widget.NextTransitions.Completed,
+		},
+		{
+			Event: "failed",
+			Next:  widget.NextTransitions.Failed,
+		},
+	}
+
+	return &flow.State{
+		Name:        widget.Name,
+		Type:        "connect-ai-assistant",
+		Transitions: transitions,
+		Properties:  widget.Properties,
+	}, nil
+}
@@ -0,0 +1,58 @@
+// Package widgets contains the studio widgets which are not currently supported by the twilio-sdk-go
+package widgets
+
+import (
+	"fmt"
+
+	"github.com/RJPearson94/twilio-sdk-go/studio/flow"
+	"github.com/RJPearson94/twilio-sdk-go/studio/properties"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+type ConnectOtherNextTransitions struct {
+	CallCompleted *string
+	Hangup        *string
+}
+
+type ConnectOtherProperties struct {
+	CallerID *string            `json:"caller_id,omitempty"`
+	Offset   *properties.Offset `json:"offset,omitempty"`
+	Record   *bool              `json:"record,omitempty"`
+	Timeout  *int               `json:"timeout,omitempty"`
+	To       string             `validate:"required" json:"to"`
+}
+
+type ConnectOther struct {
+	NextTransitions ConnectOtherNextTransitions
+	Properties      ConnectOtherProperties `validate:"required"`
+	Name            string                 `validate:"required"`
+}
+
+// Validate checks the widget is correctly configured
+func (widget ConnectOther) Validate() error {
+	if err := utils.ValidateInput(widget); err != nil {
+		return fmt.Errorf("Invalid input supplied. Errors %s", err.Error())
+	}
+	return nil
+}
+
+// ToState returns a populated Studio Widget State struct
+func (widget ConnectOther) ToState() (*flow.State, error) {
+	transitions := []flow.Transition{
+		{
+			Event: "callCompleted",
+			Next:  widget.NextTransitions.CallCompleted,
+		},
+		{
+			Event: "hangup",
+			Next:  widget.NextTransitions.Hangup,
+		},
+	}
+
+	return &flow.State{
+		Name:        widget.Name,
+		Type:        "connect-other",
+		Transitions: transitions,
+		Properties:  widget.Properties,
+	}, nil
+}
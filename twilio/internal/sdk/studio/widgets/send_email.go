@@ -0,0 +1,57 @@
+package widgets
+
+import (
+	"fmt"
+
+	"github.com/RJPearson94/twilio-sdk-go/studio/flow"
+	"github.com/RJPearson94/twilio-sdk-go/studio/properties"
+	"github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+type SendEmailNextTransitions struct {
+	Failed *string
+	Sent   *string
+}
+
+type SendEmailProperties struct {
+	Body    string             `validate:"required" json:"body"`
+	From    string             `validate:"required" json:"from"`
+	Offset  *properties.Offset `json:"offset,omitempty"`
+	Subject *string            `json:"subject,omitempty"`
+	To      string             `validate:"required" json:"to"`
+}
+
+type SendEmail struct {
+	NextTransitions SendEmailNextTransitions
+	Properties      SendEmailProperties `validate:"required"`
+	Name            string              `validate:"required"`
+}
+
+// Validate checks the widget is correctly configured
+func (widget SendEmail) Validate() error {
+	if err := utils.ValidateInput(widget); err != nil {
+		return fmt.Errorf("Invalid input supplied. Errors %s", err.Error())
+	}
+	return nil
+}
+
+// ToState returns a populated Studio Widget State struct
+func (widget SendEmail) ToState() (*flow.State, error) {
+	transitions := []flow.Transition{
+		{
+			Event: "failed",
+			Next:  widget.NextTransitions.Failed,
+		},
+		{
+			Event: "sent",
+			Next:  widget.NextTransitions.Sent,
+		},
+	}
+
+	return &flow.State{
+		Name:        widget.Name,
+		Type:        "send-email",
+		Transitions: transitions,
+		Properties:  widget.Properties,
+	}, nil
+}
@@ -73,6 +73,30 @@ func dataSourceAccountAddress() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"dependent_phone_numbers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"phone_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"emergency_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -99,6 +123,14 @@ func dataSourceAccountAddressRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Failed to read address: %s", err.Error())
 	}
 
+	paginator := meta.(*common.TwilioClient).APIExtensions.Account(accountSid).Address(sid).DependentPhoneNumbers.NewDependentPhoneNumbersPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+
+	if err := paginator.Error; err != nil {
+		return diag.Errorf("Failed to list dependent phone numbers for address: %s", err.Error())
+	}
+
 	d.SetId(getResponse.Sid)
 	d.Set("sid", getResponse.Sid)
 	d.Set("account_sid", getResponse.AccountSid)
@@ -113,6 +145,17 @@ func dataSourceAccountAddressRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("emergency_enabled", getResponse.EmergencyEnabled)
 	d.Set("validated", getResponse.Validated)
 	d.Set("verified", getResponse.Verified)
+
+	dependentPhoneNumbers := make([]interface{}, 0)
+	for _, dependentPhoneNumber := range paginator.DependentPhoneNumbers {
+		dependentPhoneNumbers = append(dependentPhoneNumbers, map[string]interface{}{
+			"sid":              dependentPhoneNumber.Sid,
+			"friendly_name":    dependentPhoneNumber.FriendlyName,
+			"phone_number":     dependentPhoneNumber.PhoneNumber,
+			"emergency_status": dependentPhoneNumber.EmergencyStatus,
+		})
+	}
+	d.Set("dependent_phone_numbers", &dependentPhoneNumbers)
 	d.Set("date_created", getResponse.DateCreated.Time.Format(time.RFC3339))
 
 	if getResponse.DateUpdated != nil {
@@ -36,6 +36,7 @@ func TestAccDataSourceTwilioAccountAddress_complete(t *testing.T) {
 					resource.TestCheckResourceAttrSet(stateDataSourceName, "emergency_enabled"),
 					resource.TestCheckResourceAttr(stateDataSourceName, "street_secondary", ""),
 					resource.TestCheckResourceAttr(stateDataSourceName, "friendly_name", ""),
+					resource.TestCheckResourceAttr(stateDataSourceName, "dependent_phone_numbers.#", "0"),
 					resource.TestCheckResourceAttrSet(stateDataSourceName, "date_created"),
 					resource.TestCheckResourceAttrSet(stateDataSourceName, "date_updated"),
 				),
@@ -0,0 +1,294 @@
+package migration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type resourceMapping struct {
+	resourceType string
+	// attributes maps the chat attribute names to the conversations attribute names where they differ
+	attributes map[string]string
+	// values maps the chat attribute values to the conversations attribute values where they differ
+	values map[string]map[string]string
+}
+
+// The chat services, roles, users, channels, members and webhooks are the same objects in the Conversations API
+var resourceMappings = map[string]resourceMapping{
+	"twilio_chat_service": {
+		resourceType: "twilio_conversations_service",
+	},
+	"twilio_chat_role": {
+		resourceType: "twilio_conversations_role",
+		values: map[string]map[string]string{
+			"type": {
+				"channel":    "conversation",
+				"deployment": "service",
+			},
+		},
+	},
+	"twilio_chat_user": {
+		resourceType: "twilio_conversations_user",
+	},
+	"twilio_chat_channel": {
+		resourceType: "twilio_conversations_conversation",
+	},
+	"twilio_chat_channel_member": {
+		resourceType: "twilio_conversations_participant",
+		attributes: map[string]string{
+			"channel_sid":                 "conversation_sid",
+			"last_consumed_message_index": "last_read_message_index",
+			"last_consumption_timestamp":  "last_read_timestamp",
+		},
+	},
+	"twilio_chat_channel_webhook": {
+		resourceType: "twilio_conversations_conversation_webhook",
+		attributes: map[string]string{
+			"channel_sid": "conversation_sid",
+			"type":        "target",
+		},
+	},
+	"twilio_chat_channel_studio_webhook": {
+		resourceType: "twilio_conversations_conversation_studio_webhook",
+		attributes: map[string]string{
+			"channel_sid": "conversation_sid",
+			"type":        "target",
+		},
+	},
+	"twilio_chat_channel_trigger_webhook": {
+		resourceType: "twilio_conversations_conversation_trigger_webhook",
+		attributes: map[string]string{
+			"channel_sid": "conversation_sid",
+			"type":        "target",
+		},
+	},
+}
+
+// MigratedResource describes a chat resource which has been rewritten to the conversations equivalent
+type MigratedResource struct {
+	From string
+	To   string
+}
+
+// Migrate rewrites the managed twilio_chat_* resources in a Terraform state file (version 4) to the twilio_conversations_* equivalent.
+// Attributes which are not supported by the conversations resource are removed, these are populated when the state is next refreshed.
+// The state serial is incremented so the migrated state can be pushed to the backend. No Twilio API calls are made
+func Migrate(state []byte, resources map[string]*schema.Resource) ([]byte, []MigratedResource, error) {
+	decoder := json.NewDecoder(bytes.NewReader(state))
+	decoder.UseNumber()
+
+	var stateMap map[string]interface{}
+	if err := decoder.Decode(&stateMap); err != nil {
+		return nil, nil, fmt.Errorf("Failed to parse state: %s", err.Error())
+	}
+
+	if version, ok := stateMap["version"].(json.Number); !ok || version.String() != "4" {
+		return nil, nil, fmt.Errorf("Only version 4 state files are supported, please run the migration with Terraform 0.12 or later")
+	}
+
+	stateResources, _ := stateMap["resources"].([]interface{})
+	addresses := make(map[string]bool)
+	for _, stateResource := range stateResources {
+		resourceMap, ok := stateResource.(map[string]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("Failed to parse state: resources must be a list of objects")
+		}
+		addresses[resourceAddress(resourceMap)] = true
+	}
+
+	migratedResources := make([]MigratedResource, 0)
+	for _, stateResource := range stateResources {
+		resourceMap := stateResource.(map[string]interface{})
+		if resourceMap["mode"] != "managed" {
+			continue
+		}
+
+		resourceType, _ := resourceMap["type"].(string)
+		mapping, ok := resourceMappings[resourceType]
+		if !ok {
+			continue
+		}
+
+		target, ok := resources[mapping.resourceType]
+		if !ok {
+			return nil, nil, fmt.Errorf("The resource type (%s) is not supported by the provider", mapping.resourceType)
+		}
+
+		from := resourceAddress(resourceMap)
+		resourceMap["type"] = mapping.resourceType
+		to := resourceAddress(resourceMap)
+
+		if addresses[to] {
+			return nil, nil, fmt.Errorf("Unable to migrate %s as %s already exists in the state", from, to)
+		}
+		addresses[to] = true
+
+		instances, _ := resourceMap["instances"].([]interface{})
+		for _, instance := range instances {
+			instanceMap, ok := instance.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if attributes, ok := instanceMap["attributes"].(map[string]interface{}); ok {
+				migratedAttributes := migrateAttributes(attributes, mapping, target.Schema)
+				// The id and timeouts attributes are managed by the SDK so are not part of the resource schema
+				if id, ok := attributes["id"]; ok {
+					migratedAttributes["id"] = id
+				}
+				if timeouts, ok := attributes["timeouts"]; ok && target.Timeouts != nil {
+					migratedAttributes["timeouts"] = timeouts
+				}
+				instanceMap["attributes"] = migratedAttributes
+			}
+			instanceMap["schema_version"] = target.SchemaVersion
+			instanceMap["sensitive_attributes"] = []interface{}{}
+		}
+
+		migratedResources = append(migratedResources, MigratedResource{
+			From: from,
+			To:   to,
+		})
+	}
+
+	// Resources which depend on a migrated resource need to reference the new resource type
+	for _, stateResource := range stateResources {
+		instances, _ := stateResource.(map[string]interface{})["instances"].([]interface{})
+		for _, instance := range instances {
+			instanceMap, ok := instance.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if dependencies, ok := instanceMap["dependencies"].([]interface{}); ok {
+				for index, dependency := range dependencies {
+					if dependencyString, ok := dependency.(string); ok {
+						dependencies[index] = migrateDependency(dependencyString)
+					}
+				}
+			}
+		}
+	}
+
+	if len(migratedResources) > 0 {
+		serial, _ := stateMap["serial"].(json.Number)
+		serialValue, err := serial.Int64()
+		if err != nil {
+			return nil, nil, fmt.Errorf("Failed to parse state: serial must be a number")
+		}
+		stateMap["serial"] = serialValue + 1
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(stateMap); err != nil {
+		return nil, nil, fmt.Errorf("Failed to marshal state: %s", err.Error())
+	}
+
+	return buffer.Bytes(), migratedResources, nil
+}
+
+func resourceAddress(resourceMap map[string]interface{}) string {
+	address := fmt.Sprintf("%s.%s", resourceMap["type"], resourceMap["name"])
+	if resourceMap["mode"] == "data" {
+		address = "data." + address
+	}
+	if module, ok := resourceMap["module"].(string); ok && module != "" {
+		address = module + "." + address
+	}
+	return address
+}
+
+func migrateDependency(dependency string) string {
+	parts := strings.Split(dependency, ".")
+	for index, part := range parts {
+		// Only managed resources are migrated, so data source references (data.<type>.<name>) are left as is
+		if mapping, ok := resourceMappings[part]; ok && (index == 0 || (index >= 2 && parts[index-2] == "module")) {
+			parts[index] = mapping.resourceType
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func migrateAttributes(attributes map[string]interface{}, mapping resourceMapping, schemaMap map[string]*schema.Schema) map[string]interface{} {
+	migratedAttributes := make(map[string]interface{})
+
+	for key, value := range attributes {
+		name := key
+		if mappedName, ok := mapping.attributes[key]; ok {
+			name = mappedName
+		}
+
+		if values, ok := mapping.values[key]; ok {
+			if stringValue, ok := value.(string); ok {
+				if mappedValue, ok := values[stringValue]; ok {
+					value = mappedValue
+				}
+			}
+		}
+
+		if attributeSchema, ok := schemaMap[name]; ok {
+			if migratedValue, ok := migrateValue(value, attributeSchema); ok {
+				migratedAttributes[name] = migratedValue
+			}
+		}
+	}
+
+	return migratedAttributes
+}
+
+// migrateValue returns the value when it can be stored against the schema, otherwise false is returned so the attribute can be removed
+func migrateValue(value interface{}, attributeSchema *schema.Schema) (interface{}, bool) {
+	if value == nil {
+		return nil, true
+	}
+
+	switch attributeSchema.Type {
+	case schema.TypeString:
+		_, ok := value.(string)
+		return value, ok
+	case schema.TypeBool:
+		_, ok := value.(bool)
+		return value, ok
+	case schema.TypeInt, schema.TypeFloat:
+		_, ok := value.(json.Number)
+		return value, ok
+	case schema.TypeMap:
+		_, ok := value.(map[string]interface{})
+		return value, ok
+	case schema.TypeList, schema.TypeSet:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		migratedItems := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			switch elem := attributeSchema.Elem.(type) {
+			case *schema.Resource:
+				itemMap, ok := item.(map[string]interface{})
+				if !ok {
+					return nil, false
+				}
+				migratedItems = append(migratedItems, migrateAttributes(itemMap, resourceMapping{}, elem.Schema))
+			case *schema.Schema:
+				migratedItem, ok := migrateValue(item, elem)
+				if !ok {
+					return nil, false
+				}
+				migratedItems = append(migratedItems, migratedItem)
+			default:
+				migratedItems = append(migratedItems, item)
+			}
+		}
+		return migratedItems, true
+	}
+
+	return nil, false
+}
@@ -27,7 +27,7 @@ func resourceChatChannel() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				format := "/Services/(.*)/Channels/(.*)"
+				format := "/Services/(.*)/(?:Channels|Conversations)/(.*)"
 				regex := regexp.MustCompile(format)
 				match := regex.FindStringSubmatch(d.Id())
 
@@ -28,7 +28,7 @@ func resourceChatChannelMember() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				format := "/Services/(.*)/Channels/(.*)/Members/(.*)"
+				format := "/Services/(.*)/(?:Channels|Conversations)/(.*)/(?:Members|Participants)/(.*)"
 				regex := regexp.MustCompile(format)
 				match := regex.FindStringSubmatch(d.Id())
 
@@ -27,7 +27,7 @@ func resourceChatChannelWebhook() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				format := "/Services/(.*)/Channels/(.*)/Webhooks/(.*)"
+				format := "/Services/(.*)/(?:Channels|Conversations)/(.*)/Webhooks/(.*)"
 				regex := regexp.MustCompile(format)
 				match := regex.FindStringSubmatch(d.Id())
 
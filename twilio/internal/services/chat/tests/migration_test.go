@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/chat/migration"
+)
+
+func TestMigrateChatState(t *testing.T) {
+	state := `{
+  "version": 4,
+  "terraform_version": "1.5.7",
+  "serial": 7,
+  "lineage": "3f9b2a6e-2c4b-4f3a-9a51-6a3f5d7e8b91",
+  "outputs": {},
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "twilio_chat_service",
+      "name": "service",
+      "provider": "provider[\"registry.terraform.io/RJPearson94/twilio\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {
+            "id": "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "sid": "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "account_sid": "ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "friendly_name": "chat",
+            "reachability_enabled": false,
+            "limits": [{"channel_members": 100, "user_channels": 250}],
+            "timeouts": null
+          },
+          "sensitive_attributes": []
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "twilio_chat_role",
+      "name": "role",
+      "provider": "provider[\"registry.terraform.io/RJPearson94/twilio\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {
+            "id": "RLaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "sid": "RLaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "service_sid": "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "friendly_name": "role",
+            "type": "channel",
+            "permissions": ["sendMessage"]
+          },
+          "sensitive_attributes": [],
+          "dependencies": ["twilio_chat_service.service"]
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "twilio_chat_channel_member",
+      "name": "member",
+      "module": "module.chat",
+      "provider": "provider[\"registry.terraform.io/RJPearson94/twilio\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {
+            "id": "MBaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "sid": "MBaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "service_sid": "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "channel_sid": "CHaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+            "identity": "user",
+            "last_consumed_message_index": 5,
+            "last_consumption_timestamp": "2021-01-01T00:00:00Z"
+          },
+          "sensitive_attributes": [],
+          "dependencies": ["module.chat.twilio_chat_channel.channel", "data.twilio_chat_service.service"]
+        }
+      ]
+    },
+    {
+      "mode": "data",
+      "type": "twilio_chat_service",
+      "name": "service",
+      "provider": "provider[\"registry.terraform.io/RJPearson94/twilio\"]",
+      "instances": []
+    }
+  ]
+}`
+
+	migratedState, migratedResources, err := migration.Migrate([]byte(state), twilio.Provider().ResourcesMap)
+	if err != nil {
+		t.Fatalf("Failed to migrate state: %s", err.Error())
+	}
+
+	if len(migratedResources) != 3 {
+		t.Fatalf("Expected 3 migrated resources but found %d", len(migratedResources))
+	}
+	if migratedResources[2].From != "module.chat.twilio_chat_channel_member.member" || migratedResources[2].To != "module.chat.twilio_conversations_participant.member" {
+		t.Errorf("Unexpected migrated resource %s to %s", migratedResources[2].From, migratedResources[2].To)
+	}
+
+	var stateMap map[string]interface{}
+	if err := json.Unmarshal(migratedState, &stateMap); err != nil {
+		t.Fatalf("Failed to parse migrated state: %s", err.Error())
+	}
+
+	if stateMap["serial"] != float64(8) {
+		t.Errorf("Expected the serial to be incremented to 8 but found %v", stateMap["serial"])
+	}
+
+	resources := stateMap["resources"].([]interface{})
+
+	service := resources[0].(map[string]interface{})
+	serviceAttributes := service["instances"].([]interface{})[0].(map[string]interface{})["attributes"].(map[string]interface{})
+	if service["type"] != "twilio_conversations_service" {
+		t.Errorf("Expected the service type to be twilio_conversations_service but found %s", service["type"])
+	}
+	for _, attribute := range []string{"id", "sid", "friendly_name", "timeouts"} {
+		if _, ok := serviceAttributes[attribute]; !ok {
+			t.Errorf("Expected the service attribute %s to be retained", attribute)
+		}
+	}
+	for _, attribute := range []string{"reachability_enabled", "limits"} {
+		if _, ok := serviceAttributes[attribute]; ok {
+			t.Errorf("Expected the service attribute %s to be removed", attribute)
+		}
+	}
+
+	role := resources[1].(map[string]interface{})
+	roleInstance := role["instances"].([]interface{})[0].(map[string]interface{})
+	if roleType := roleInstance["attributes"].(map[string]interface{})["type"]; roleType != "conversation" {
+		t.Errorf("Expected the role type to be conversation but found %s", roleType)
+	}
+	if dependency := roleInstance["dependencies"].([]interface{})[0]; dependency != "twilio_conversations_service.service" {
+		t.Errorf("Expected the role dependency to be twilio_conversations_service.service but found %s", dependency)
+	}
+
+	member := resources[2].(map[string]interface{})
+	memberInstance := member["instances"].([]interface{})[0].(map[string]interface{})
+	memberAttributes := memberInstance["attributes"].(map[string]interface{})
+	if member["type"] != "twilio_conversations_participant" {
+		t.Errorf("Expected the member type to be twilio_conversations_participant but found %s", member["type"])
+	}
+	if memberAttributes["conversation_sid"] != "CHaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" {
+		t.Errorf("Expected the channel_sid to be migrated to conversation_sid but found %v", memberAttributes["conversation_sid"])
+	}
+	if memberAttributes["last_read_message_index"] != float64(5) {
+		t.Errorf("Expected the last_consumed_message_index to be migrated to last_read_message_index but found %v", memberAttributes["last_read_message_index"])
+	}
+	dependencies := memberInstance["dependencies"].([]interface{})
+	if dependencies[0] != "module.chat.twilio_conversations_conversation.channel" || dependencies[1] != "data.twilio_chat_service.service" {
+		t.Errorf("Unexpected member dependencies %v", dependencies)
+	}
+
+	dataSource := resources[3].(map[string]interface{})
+	if dataSource["type"] != "twilio_chat_service" {
+		t.Errorf("Expected the data source type to be unchanged but found %s", dataSource["type"])
+	}
+}
+
+func TestMigrateChatStateWithExistingConversationsResource(t *testing.T) {
+	state := `{
+  "version": 4,
+  "serial": 1,
+  "resources": [
+    {"mode": "managed", "type": "twilio_chat_service", "name": "service", "instances": []},
+    {"mode": "managed", "type": "twilio_conversations_service", "name": "service", "instances": []}
+  ]
+}`
+
+	_, _, err := migration.Migrate([]byte(state), twilio.Provider().ResourcesMap)
+	if err == nil || !strings.Contains(err.Error(), "Unable to migrate twilio_chat_service.service as twilio_conversations_service.service already exists in the state") {
+		t.Fatalf("Expected an existing resource error but got %v", err)
+	}
+}
+
+func TestMigrateChatStateWithUnsupportedVersion(t *testing.T) {
+	_, _, err := migration.Migrate([]byte(`{"version": 3, "serial": 1}`), twilio.Provider().ResourcesMap)
+	if err == nil || !strings.Contains(err.Error(), "Only version 4 state files are supported") {
+		t.Fatalf("Expected an unsupported version error but got %v", err)
+	}
+}
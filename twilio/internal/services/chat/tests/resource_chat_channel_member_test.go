@@ -107,6 +107,32 @@ func TestAccTwilioChatChannelMember_update(t *testing.T) {
 	})
 }
 
+func TestAccTwilioChatChannelMember_importConversationsFormat(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.member", channelMemberResourceName)
+	friendlyName := acctest.RandString(10)
+	identity := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioChatChannelMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioChatChannelMember_basic(friendlyName, identity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioChatChannelMemberExists(stateResourceName),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioChatChannelMemberConversationsImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccTwilioChatChannelMember_invalidServiceSid(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -197,6 +223,17 @@ func testAccTwilioChatChannelMemberImportStateIdFunc(name string) resource.Impor
 	}
 }
 
+func testAccTwilioChatChannelMemberConversationsImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("/Services/%s/Conversations/%s/Participants/%s", rs.Primary.Attributes["service_sid"], rs.Primary.Attributes["channel_sid"], rs.Primary.Attributes["sid"]), nil
+	}
+}
+
 func testAccTwilioChatChannelMember_basic(friendlyName string, identity string) string {
 	return fmt.Sprintf(`
 resource "twilio_chat_service" "service" {
@@ -110,6 +110,31 @@ func TestAccTwilioChatChannel_update(t *testing.T) {
 	})
 }
 
+func TestAccTwilioChatChannel_importConversationsFormat(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.channel", channelResourceName)
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioChatChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioChatChannel_basic(friendlyName, "private"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioChatChannelExists(stateResourceName),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioChatChannelConversationsImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccTwilioChatChannel_invalidType(t *testing.T) {
 	friendlyName := acctest.RandString(10)
 	channelType := "test"
@@ -191,6 +216,17 @@ func testAccTwilioChatChannelImportStateIdFunc(name string) resource.ImportState
 	}
 }
 
+func testAccTwilioChatChannelConversationsImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("/Services/%s/Conversations/%s", rs.Primary.Attributes["service_sid"], rs.Primary.Attributes["sid"]), nil
+	}
+}
+
 func testAccTwilioChatChannel_basic(friendlyName string, channelType string) string {
 	return fmt.Sprintf(`
 resource "twilio_chat_service" "service" {
@@ -0,0 +1,172 @@
+package conversations
+
+import (
+	"context"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceConversationsParticipants() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConversationsParticipantsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.ConversationServiceSidValidation(),
+			},
+			"conversation_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.ConversationSidValidation(),
+			},
+			"participants": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"messaging_binding": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"proxy_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"projected_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"attributes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_read_message_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_read_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConversationsParticipantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Conversations
+
+	serviceSid := d.Get("service_sid").(string)
+	conversationSid := d.Get("conversation_sid").(string)
+	paginator := client.Service(serviceSid).Conversation(conversationSid).Participants.NewParticipantsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+
+	err := paginator.Error()
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return diag.Errorf("No participants were found for service with sid (%s) and conversation with sid (%s)", serviceSid, conversationSid)
+		}
+		return diag.Errorf("Failed to list conversations participants: %s", err.Error())
+	}
+
+	d.SetId(serviceSid + "/" + conversationSid)
+	d.Set("service_sid", serviceSid)
+	d.Set("conversation_sid", conversationSid)
+
+	participants := make([]interface{}, 0)
+
+	for _, participant := range paginator.Participants {
+		d.Set("account_sid", participant.AccountSid)
+
+		participantMap := make(map[string]interface{})
+
+		participantMap["sid"] = participant.Sid
+		participantMap["identity"] = participant.Identity
+
+		if participant.MessagingBinding != nil {
+			participantMap["messaging_binding"] = []interface{}{
+				map[string]interface{}{
+					"address":           participant.MessagingBinding.Address,
+					"proxy_address":     participant.MessagingBinding.ProxyAddress,
+					"projected_address": participant.MessagingBinding.ProjectedAddress,
+					"type":              participant.MessagingBinding.Type,
+				},
+			}
+		}
+
+		participantMap["attributes"] = participant.Attributes
+		participantMap["role_sid"] = participant.RoleSid
+		participantMap["last_read_message_index"] = participant.LastReadMessageIndex
+
+		if participant.LastReadTimestamp != nil {
+			participantMap["last_read_timestamp"] = participant.LastReadTimestamp.Format(time.RFC3339)
+		}
+
+		participantMap["date_created"] = participant.DateCreated.Format(time.RFC3339)
+
+		if participant.DateUpdated != nil {
+			participantMap["date_updated"] = participant.DateUpdated.Format(time.RFC3339)
+		}
+
+		participantMap["url"] = participant.URL
+
+		participants = append(participants, participantMap)
+	}
+
+	d.Set("participants", &participants)
+
+	return nil
+}
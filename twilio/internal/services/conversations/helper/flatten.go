@@ -6,6 +6,7 @@ import (
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
 	"github.com/RJPearson94/twilio-sdk-go/service/conversations/v1/service/configuration/notification"
 	"github.com/RJPearson94/twilio-sdk-go/service/conversations/v1/service/conversation"
+	"github.com/RJPearson94/twilio-sdk-go/service/conversations/v1/service/conversation/participant"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,3 +51,14 @@ func FlattenNotificationsNewMessage(input notification.FetchNotificationNewMessa
 		},
 	}
 }
+
+func FlattenMessagingBinding(input participant.FetchParticipantMessageBindingResponse) *[]interface{} {
+	return &[]interface{}{
+		map[string]interface{}{
+			"address":           input.Address,
+			"proxy_address":     input.ProxyAddress,
+			"projected_address": input.ProjectedAddress,
+			"type":              input.Type,
+		},
+	}
+}
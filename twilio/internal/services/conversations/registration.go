@@ -18,6 +18,7 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 		"twilio_conversations_conversation_webhooks": dataSourceConversationsConversationWebhooks(),
 		"twilio_conversations_conversation":          dataSourceConversationsConversation(),
 		"twilio_conversations_conversations":         dataSourceConversationsConversations(),
+		"twilio_conversations_participants":          dataSourceConversationsParticipants(),
 		"twilio_conversations_role":                  dataSourceConversationsRole(),
 		"twilio_conversations_roles":                 dataSourceConversationsRoles(),
 		"twilio_conversations_service_configuration": dataSourceConversationsServiceConfiguration(),
@@ -33,13 +34,14 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"twilio_conversations_address_configuration_default": resourceConversationsAddressConfigurationDefault(),
-		"twilio_conversations_address_configuration_studio":  resourceConversationsAddressConfigurationStudio(),
+		"twilio_conversations_address_configuration_studio":  ResourceConversationsAddressConfigurationStudio(),
 		"twilio_conversations_address_configuration_webhook": resourceConversationsAddressConfigurationWebhook(),
 		"twilio_conversations_configuration":                 resourceConversationsConfiguration(),
 		"twilio_conversations_conversation_studio_webhook":   resourceConversationsConversationStudioWebhook(),
 		"twilio_conversations_conversation_trigger_webhook":  resourceConversationsConversationTriggerWebhook(),
 		"twilio_conversations_conversation_webhook":          resourceConversationsConversationWebhook(),
 		"twilio_conversations_conversation":                  resourceConversationsConversation(),
+		"twilio_conversations_participant":                   resourceConversationsParticipant(),
 		"twilio_conversations_push_credential_apn":           resourceConversationsPushCredentialAPN(),
 		"twilio_conversations_push_credential_fcm":           resourceConversationsPushCredentialFCM(),
 		"twilio_conversations_role":                          resourceConversationsRole(),
@@ -10,12 +10,14 @@ import (
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
 	"github.com/RJPearson94/twilio-sdk-go/service/conversations/v1/configuration/address"
 	"github.com/RJPearson94/twilio-sdk-go/service/conversations/v1/configuration/addresses"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceConversationsAddressConfigurationStudio() *schema.Resource {
+// ResourceConversationsAddressConfigurationStudio is exported so the flex conversations address configuration can build on the studio address configuration
+func ResourceConversationsAddressConfigurationStudio() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceConversationsAddressConfigurationStudioCreate,
 		ReadContext:   resourceConversationsAddressConfigurationStudioRead,
@@ -123,7 +125,7 @@ func resourceConversationsAddressConfigurationStudioCreate(ctx context.Context,
 			Enabled:                utils.OptionalBool(d, "enabled"),
 			Type:                   &studioType,
 			StudioFlowSid:          utils.OptionalString(d, "flow_sid"),
-			StudioRetryCount:       utils.OptionalInt(d, "retry_count"),
+			StudioRetryCount:       sdkUtils.Int(d.Get("retry_count").(int)),
 		},
 		FriendlyName: utils.OptionalStringWithEmptyStringOnChange(d, "friendly_name"),
 		Type:         d.Get("type").(string),
@@ -181,7 +183,7 @@ func resourceConversationsAddressConfigurationStudioUpdate(ctx context.Context,
 			Enabled:                utils.OptionalBool(d, "enabled"),
 			Type:                   &studioType,
 			StudioFlowSid:          utils.OptionalString(d, "flow_sid"),
-			StudioRetryCount:       utils.OptionalInt(d, "retry_count"),
+			StudioRetryCount:       sdkUtils.Int(d.Get("retry_count").(int)),
 		},
 		FriendlyName: utils.OptionalStringWithEmptyStringOnChange(d, "friendly_name"),
 	}
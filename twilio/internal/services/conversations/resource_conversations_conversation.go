@@ -26,7 +26,7 @@ func resourceConversationsConversation() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				format := "/Services/(.*)/Conversations/(.*)"
+				format := "/Services/(.*)/(?:Conversations|Channels)/(.*)"
 				regex := regexp.MustCompile(format)
 				match := regex.FindStringSubmatch(d.Id())
 
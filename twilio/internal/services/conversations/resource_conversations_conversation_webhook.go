@@ -24,7 +24,7 @@ func resourceConversationsConversationWebhook() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				format := "/Services/(.*)/Conversations/(.*)/Webhooks/(.*)"
+				format := "/Services/(.*)/(?:Conversations|Channels)/(.*)/Webhooks/(.*)"
 				regex := regexp.MustCompile(format)
 				match := regex.FindStringSubmatch(d.Id())
 
@@ -0,0 +1,253 @@
+package conversations
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/conversations/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/service/conversations/v1/service/conversation/participant"
+	"github.com/RJPearson94/twilio-sdk-go/service/conversations/v1/service/conversation/participants"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceConversationsParticipant() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConversationsParticipantCreate,
+		ReadContext:   resourceConversationsParticipantRead,
+		UpdateContext: resourceConversationsParticipantUpdate,
+		DeleteContext: resourceConversationsParticipantDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				format := "/Services/(.*)/(?:Conversations|Channels)/(.*)/(?:Participants|Members)/(.*)"
+				regex := regexp.MustCompile(format)
+				match := regex.FindStringSubmatch(d.Id())
+
+				if len(match) != 4 {
+					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
+				}
+
+				if _, errs := utils.ConversationParticipantSidValidation()(match[3], "sid"); len(errs) > 0 {
+					return nil, fmt.Errorf("The imported ID (%s) does not contain a valid participant SID: %s", d.Id(), errs[0].Error())
+				}
+
+				d.Set("service_sid", match[1])
+				d.Set("conversation_sid", match[2])
+				d.Set("sid", match[3])
+				d.SetId(match[3])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.ConversationServiceSidValidation(),
+			},
+			"conversation_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.ConversationSidValidation(),
+			},
+			"identity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				AtLeastOneOf: []string{"identity", "messaging_binding"},
+			},
+			"messaging_binding": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"identity", "messaging_binding"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"proxy_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"projected_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"attributes": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "{}",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"role_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: utils.ConversationRoleSidValidation(),
+			},
+			"last_read_message_index": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_read_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceConversationsParticipantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Conversations
+
+	createInput := &participants.CreateParticipantInput{
+		Attributes: utils.OptionalJSONString(d, "attributes"),
+		Identity:   utils.OptionalString(d, "identity"),
+		RoleSid:    utils.OptionalString(d, "role_sid"),
+	}
+
+	if _, ok := d.GetOk("messaging_binding"); ok {
+		createInput.MessagingBinding = &participants.CreateParticipantMessageBindingInput{
+			Address:          utils.OptionalString(d, "messaging_binding.0.address"),
+			ProxyAddress:     utils.OptionalString(d, "messaging_binding.0.proxy_address"),
+			ProjectedAddress: utils.OptionalString(d, "messaging_binding.0.projected_address"),
+		}
+	}
+
+	createResult, err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Participants.CreateWithContext(ctx, createInput)
+	if err != nil {
+		return diag.Errorf("Failed to create conversations participant: %s", err.Error())
+	}
+
+	d.SetId(createResult.Sid)
+	return resourceConversationsParticipantRead(ctx, d, meta)
+}
+
+func resourceConversationsParticipantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Conversations
+
+	getResponse, err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Participant(d.Id()).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read conversations participant: %s", err.Error())
+	}
+
+	d.Set("sid", getResponse.Sid)
+	d.Set("account_sid", getResponse.AccountSid)
+	d.Set("service_sid", getResponse.ChatServiceSid)
+	d.Set("conversation_sid", getResponse.ConversationSid)
+	d.Set("identity", getResponse.Identity)
+
+	if getResponse.MessagingBinding != nil {
+		d.Set("messaging_binding", helper.FlattenMessagingBinding(*getResponse.MessagingBinding))
+	} else {
+		d.Set("messaging_binding", nil)
+	}
+
+	d.Set("attributes", getResponse.Attributes)
+	d.Set("role_sid", getResponse.RoleSid)
+	d.Set("last_read_message_index", getResponse.LastReadMessageIndex)
+
+	if getResponse.LastReadTimestamp != nil {
+		d.Set("last_read_timestamp", getResponse.LastReadTimestamp.Format(time.RFC3339))
+	}
+
+	d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
+
+	if getResponse.DateUpdated != nil {
+		d.Set("date_updated", getResponse.DateUpdated.Format(time.RFC3339))
+	}
+
+	d.Set("url", getResponse.URL)
+
+	return nil
+}
+
+func resourceConversationsParticipantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Conversations
+
+	updateInput := &participant.UpdateParticipantInput{
+		Attributes: utils.OptionalJSONString(d, "attributes"),
+		RoleSid:    utils.OptionalString(d, "role_sid"),
+	}
+
+	if _, ok := d.GetOk("messaging_binding"); ok {
+		updateInput.MessagingBinding = &participant.UpdateParticipantMessageBindingInput{
+			ProxyAddress:     utils.OptionalString(d, "messaging_binding.0.proxy_address"),
+			ProjectedAddress: utils.OptionalString(d, "messaging_binding.0.projected_address"),
+		}
+	}
+
+	updateResp, err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Participant(d.Id()).UpdateWithContext(ctx, updateInput)
+	if err != nil {
+		return diag.Errorf("Failed to update conversations participant: %s", err.Error())
+	}
+
+	d.SetId(updateResp.Sid)
+	return resourceConversationsParticipantRead(ctx, d, meta)
+}
+
+func resourceConversationsParticipantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Conversations
+
+	if err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Participant(d.Id()).DeleteWithContext(ctx); err != nil {
+		return diag.Errorf("Failed to delete conversations participant: %s", err.Error())
+	}
+	d.SetId("")
+	return nil
+}
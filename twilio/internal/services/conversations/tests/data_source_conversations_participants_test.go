@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var participantsDataSourceName = "twilio_conversations_participants"
+
+func TestAccDataSourceTwilioConversationsParticipants_basic(t *testing.T) {
+	stateDataSource := fmt.Sprintf("data.%s.participants", participantsDataSourceName)
+	friendlyName := acctest.RandString(10)
+	identity := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioConversationsParticipants_basic(friendlyName, identity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSource, "id"),
+					resource.TestCheckResourceAttrSet(stateDataSource, "account_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSource, "service_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSource, "conversation_sid"),
+					resource.TestCheckResourceAttr(stateDataSource, "participants.#", "1"),
+					resource.TestCheckResourceAttrSet(stateDataSource, "participants.0.sid"),
+					resource.TestCheckResourceAttr(stateDataSource, "participants.0.identity", identity),
+					resource.TestCheckResourceAttr(stateDataSource, "participants.0.attributes", "{}"),
+					resource.TestCheckResourceAttr(stateDataSource, "participants.0.messaging_binding.#", "0"),
+					resource.TestCheckResourceAttrSet(stateDataSource, "participants.0.role_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSource, "participants.0.date_created"),
+					resource.TestCheckResourceAttrSet(stateDataSource, "participants.0.url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioConversationsParticipants_invalidServiceSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioConversationsParticipants_invalidServiceSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of service_sid to match regular expression "\^IS\[0-9a-fA-F\]\{32\}\$", got service_sid`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioConversationsParticipants_invalidConversationSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioConversationsParticipants_invalidConversationSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of conversation_sid to match regular expression "\^CH\[0-9a-fA-F\]\{32\}\$", got conversation_sid`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioConversationsParticipants_basic(friendlyName string, identity string) string {
+	return fmt.Sprintf(`
+resource "twilio_conversations_service" "service" {
+  friendly_name = "%s"
+}
+
+resource "twilio_conversations_conversation" "conversation" {
+  service_sid = twilio_conversations_service.service.sid
+}
+
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = twilio_conversations_service.service.sid
+  conversation_sid = twilio_conversations_conversation.conversation.sid
+  identity         = "%s"
+}
+
+data "twilio_conversations_participants" "participants" {
+  service_sid      = twilio_conversations_participant.participant.service_sid
+  conversation_sid = twilio_conversations_participant.participant.conversation_sid
+}
+`, friendlyName, identity)
+}
+
+func testAccDataSourceTwilioConversationsParticipants_invalidServiceSid() string {
+	return `
+data "twilio_conversations_participants" "participants" {
+  service_sid      = "service_sid"
+  conversation_sid = "CHaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+}
+`
+}
+
+func testAccDataSourceTwilioConversationsParticipants_invalidConversationSid() string {
+	return `
+data "twilio_conversations_participants" "participants" {
+  service_sid      = "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  conversation_sid = "conversation_sid"
+}
+`
+}
@@ -108,6 +108,36 @@ func TestAccTwilioConversationsConversation_update(t *testing.T) {
 	})
 }
 
+func TestAccTwilioConversationsConversation_importChatChannel(t *testing.T) {
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioConversationsConversationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioConversationsConversation_chatChannel(friendlyName),
+			},
+			{
+				Config:            testAccTwilioConversationsConversation_importChatChannel(friendlyName),
+				ResourceName:      fmt.Sprintf("%s.conversation", conversationResourceName),
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioConversationsConversationChatImportStateIdFunc("twilio_chat_channel.channel"),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("Expected 1 imported conversation but found %d", len(states))
+					}
+					if states[0].Attributes["friendly_name"] != friendlyName {
+						return fmt.Errorf("Expected the imported conversation friendly name to be %s but found %s", friendlyName, states[0].Attributes["friendly_name"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func TestAccTwilioConversationsConversation_attributes(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.conversation", conversationResourceName)
 	friendlyName := acctest.RandString(10)
@@ -405,6 +435,17 @@ func testAccTwilioConversationsConversationImportStateIdFunc(name string) resour
 	}
 }
 
+func testAccTwilioConversationsConversationChatImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("/Services/%s/Channels/%s", rs.Primary.Attributes["service_sid"], rs.Primary.Attributes["sid"]), nil
+	}
+}
+
 func testAccTwilioConversationsConversation_basic(friendlyName string) string {
 	return fmt.Sprintf(`
 resource "twilio_conversations_service" "service" {
@@ -417,6 +458,31 @@ resource "twilio_conversations_conversation" "conversation" {
 `, friendlyName)
 }
 
+func testAccTwilioConversationsConversation_chatChannel(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_chat_service" "service" {
+  friendly_name = "%[1]s"
+}
+
+resource "twilio_chat_channel" "channel" {
+  service_sid   = twilio_chat_service.service.sid
+  friendly_name = "%[1]s"
+  type          = "private"
+}
+`, friendlyName)
+}
+
+func testAccTwilioConversationsConversation_importChatChannel(friendlyName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "twilio_conversations_conversation" "conversation" {
+  service_sid   = twilio_chat_service.service.sid
+  friendly_name = "%s"
+}
+`, testAccTwilioConversationsConversation_chatChannel(friendlyName), friendlyName)
+}
+
 func testAccTwilioConversationsConversation_friendlyName(friendlyName string, conversationFriendlyName string) string {
 	return fmt.Sprintf(`
 resource "twilio_conversations_service" "service" {
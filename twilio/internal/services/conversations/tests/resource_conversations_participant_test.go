@@ -0,0 +1,426 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var participantResourceName = "twilio_conversations_participant"
+
+func TestAccTwilioConversationsParticipant_basic(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.participant", participantResourceName)
+	friendlyName := acctest.RandString(10)
+	identity := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioConversationsParticipantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioConversationsParticipant_basic(friendlyName, identity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioConversationsParticipantExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "identity", identity),
+					resource.TestCheckResourceAttr(stateResourceName, "attributes", "{}"),
+					resource.TestCheckResourceAttr(stateResourceName, "messaging_binding.#", "0"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "account_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "service_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "conversation_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "role_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "date_created"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "url"),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioConversationsParticipantImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTwilioConversationsParticipant_attributes(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.participant", participantResourceName)
+	friendlyName := acctest.RandString(10)
+	identity := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioConversationsParticipantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioConversationsParticipant_basic(friendlyName, identity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioConversationsParticipantExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "attributes", "{}"),
+				),
+			},
+			{
+				Config: testAccTwilioConversationsParticipant_withAttributes(friendlyName, identity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioConversationsParticipantExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "attributes", `{"test":true}`),
+				),
+			},
+			{
+				Config: testAccTwilioConversationsParticipant_basic(friendlyName, identity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioConversationsParticipantExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "attributes", "{}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioConversationsParticipant_role(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.participant", participantResourceName)
+	friendlyName := acctest.RandString(10)
+	identity := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioConversationsParticipantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioConversationsParticipant_withRole(friendlyName, identity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioConversationsParticipantExists(stateResourceName),
+					resource.TestCheckResourceAttrPair(stateResourceName, "role_sid", "twilio_conversations_role.role", "sid"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioConversationsParticipant_projectedAddress(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.participant", participantResourceName)
+	friendlyName := acctest.RandString(10)
+	identity := acctest.RandString(10)
+	phoneNumber := acceptance.TestAccData.PhoneNumber
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioConversationsParticipantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioConversationsParticipant_projectedAddress(friendlyName, identity, phoneNumber),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioConversationsParticipantExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "identity", identity),
+					resource.TestCheckResourceAttr(stateResourceName, "messaging_binding.#", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "messaging_binding.0.projected_address", phoneNumber),
+					resource.TestCheckResourceAttrSet(stateResourceName, "messaging_binding.0.type"),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioConversationsParticipantImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTwilioConversationsParticipant_importChatFormat(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.participant", participantResourceName)
+	friendlyName := acctest.RandString(10)
+	identity := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioConversationsParticipantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioConversationsParticipant_basic(friendlyName, identity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioConversationsParticipantExists(stateResourceName),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioConversationsParticipantChatImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTwilioConversationsParticipant_missingIdentityAndMessagingBinding(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioConversationsParticipant_missingIdentityAndMessagingBinding(),
+				ExpectError: regexp.MustCompile(`(?s)one of ` + "`identity,messaging_binding`" + ` must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioConversationsParticipant_invalidAttributesString(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioConversationsParticipant_invalidAttributesString(),
+				ExpectError: regexp.MustCompile(`(?s)"attributes" contains an invalid JSON`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioConversationsParticipant_invalidServiceSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioConversationsParticipant_invalidServiceSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of service_sid to match regular expression "\^IS\[0-9a-fA-F\]\{32\}\$", got service_sid`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioConversationsParticipant_invalidConversationSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioConversationsParticipant_invalidConversationSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of conversation_sid to match regular expression "\^CH\[0-9a-fA-F\]\{32\}\$", got conversation_sid`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioConversationsParticipant_invalidRoleSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioConversationsParticipant_invalidRoleSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of role_sid to match regular expression "\^RL\[0-9a-fA-F\]\{32\}\$", got role_sid`),
+			},
+		},
+	})
+}
+
+func testAccCheckTwilioConversationsParticipantDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Conversations
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != participantResourceName {
+			continue
+		}
+
+		if _, err := client.Service(rs.Primary.Attributes["service_sid"]).Conversation(rs.Primary.Attributes["conversation_sid"]).Participant(rs.Primary.ID).Fetch(); err != nil {
+			if utils.IsNotFoundError(err) {
+				return nil
+			}
+			return fmt.Errorf("Error occurred when retrieving participant information %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTwilioConversationsParticipantExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Conversations
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if _, err := client.Service(rs.Primary.Attributes["service_sid"]).Conversation(rs.Primary.Attributes["conversation_sid"]).Participant(rs.Primary.ID).Fetch(); err != nil {
+			return fmt.Errorf("Error occurred when retrieving participant information %s", err.Error())
+		}
+
+		return nil
+	}
+}
+
+func testAccTwilioConversationsParticipantImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("/Services/%s/Conversations/%s/Participants/%s", rs.Primary.Attributes["service_sid"], rs.Primary.Attributes["conversation_sid"], rs.Primary.Attributes["sid"]), nil
+	}
+}
+
+func testAccTwilioConversationsParticipantChatImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("/Services/%s/Channels/%s/Members/%s", rs.Primary.Attributes["service_sid"], rs.Primary.Attributes["conversation_sid"], rs.Primary.Attributes["sid"]), nil
+	}
+}
+
+func testAccTwilioConversationsParticipant_basic(friendlyName string, identity string) string {
+	return fmt.Sprintf(`
+resource "twilio_conversations_service" "service" {
+  friendly_name = "%s"
+}
+
+resource "twilio_conversations_conversation" "conversation" {
+  service_sid = twilio_conversations_service.service.sid
+}
+
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = twilio_conversations_service.service.sid
+  conversation_sid = twilio_conversations_conversation.conversation.sid
+  identity         = "%s"
+}
+`, friendlyName, identity)
+}
+
+func testAccTwilioConversationsParticipant_withAttributes(friendlyName string, identity string) string {
+	return fmt.Sprintf(`
+resource "twilio_conversations_service" "service" {
+  friendly_name = "%s"
+}
+
+resource "twilio_conversations_conversation" "conversation" {
+  service_sid = twilio_conversations_service.service.sid
+}
+
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = twilio_conversations_service.service.sid
+  conversation_sid = twilio_conversations_conversation.conversation.sid
+  identity         = "%s"
+  attributes       = "{\"test\": true}"
+}
+`, friendlyName, identity)
+}
+
+func testAccTwilioConversationsParticipant_withRole(friendlyName string, identity string) string {
+	return fmt.Sprintf(`
+resource "twilio_conversations_service" "service" {
+  friendly_name = "%[1]s"
+}
+
+resource "twilio_conversations_role" "role" {
+  service_sid   = twilio_conversations_service.service.sid
+  friendly_name = "%[1]s"
+  type          = "conversation"
+  permissions   = ["sendMessage"]
+}
+
+resource "twilio_conversations_conversation" "conversation" {
+  service_sid = twilio_conversations_service.service.sid
+}
+
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = twilio_conversations_service.service.sid
+  conversation_sid = twilio_conversations_conversation.conversation.sid
+  identity         = "%[2]s"
+  role_sid         = twilio_conversations_role.role.sid
+}
+`, friendlyName, identity)
+}
+
+func testAccTwilioConversationsParticipant_projectedAddress(friendlyName string, identity string, phoneNumber string) string {
+	return fmt.Sprintf(`
+resource "twilio_conversations_service" "service" {
+  friendly_name = "%s"
+}
+
+resource "twilio_conversations_conversation" "conversation" {
+  service_sid = twilio_conversations_service.service.sid
+}
+
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = twilio_conversations_service.service.sid
+  conversation_sid = twilio_conversations_conversation.conversation.sid
+  identity         = "%s"
+
+  messaging_binding {
+    projected_address = "%s"
+  }
+}
+`, friendlyName, identity, phoneNumber)
+}
+
+func testAccTwilioConversationsParticipant_missingIdentityAndMessagingBinding() string {
+	return `
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  conversation_sid = "CHaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+}
+`
+}
+
+func testAccTwilioConversationsParticipant_invalidAttributesString() string {
+	return `
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  conversation_sid = "CHaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  identity         = "invalid_attributes"
+  attributes       = "attributes"
+}
+`
+}
+
+func testAccTwilioConversationsParticipant_invalidServiceSid() string {
+	return `
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = "service_sid"
+  conversation_sid = "CHaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  identity         = "invalid_service_sid"
+}
+`
+}
+
+func testAccTwilioConversationsParticipant_invalidConversationSid() string {
+	return `
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  conversation_sid = "conversation_sid"
+  identity         = "invalid_conversation_sid"
+}
+`
+}
+
+func testAccTwilioConversationsParticipant_invalidRoleSid() string {
+	return `
+resource "twilio_conversations_participant" "participant" {
+  service_sid      = "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  conversation_sid = "CHaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  identity         = "invalid_role_sid"
+  role_sid         = "role_sid"
+}
+`
+}
@@ -0,0 +1,143 @@
+package flex
+
+import (
+	"context"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/flex/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFlexActiveRelease() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFlexActiveReleaseRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plugins": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"plugin_version_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"plugin_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"plugin_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"phase": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"private": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"unique_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"previous_release_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_configuration_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFlexActiveReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Flex
+
+	activeRelease, previousRelease, err := fetchActiveReleases(ctx, client)
+	if err != nil {
+		return diag.Errorf("Failed to read flex plugin releases: %s", err.Error())
+	}
+
+	if activeRelease == nil {
+		return diag.Errorf("No active flex plugin release was found")
+	}
+
+	getConfigurationResponse, err := client.PluginConfiguration(activeRelease.ConfigurationSid).FetchWithContext(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to read flex plugin configuration: %s", err.Error())
+	}
+
+	paginator := client.PluginConfiguration(activeRelease.ConfigurationSid).Plugins.NewPluginsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+
+	if err := paginator.Error(); err != nil {
+		return diag.Errorf("Failed to read flex plugin configuration plugins: %s", err.Error())
+	}
+
+	d.SetId(activeRelease.Sid)
+	d.Set("sid", activeRelease.Sid)
+	d.Set("account_sid", activeRelease.AccountSid)
+	d.Set("configuration_sid", activeRelease.ConfigurationSid)
+	d.Set("configuration_name", getConfigurationResponse.Name)
+	d.Set("plugins", helper.FlattenPlugins(paginator.Plugins))
+
+	if previousRelease != nil {
+		d.Set("previous_release_sid", previousRelease.Sid)
+		d.Set("previous_configuration_sid", previousRelease.ConfigurationSid)
+	}
+
+	d.Set("date_created", activeRelease.DateCreated.Format(time.RFC3339))
+	d.Set("url", activeRelease.URL)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package flex
+
+import (
+	"context"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFlexConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFlexConfigurationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ui_attributes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"queue_stats_configuration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"taskrouter_skills": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"taskrouter_target_workflow_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"taskrouter_target_taskqueue_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"taskrouter_offline_activity_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crm_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"crm_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crm_callback_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crm_fallback_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crm_attributes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"taskrouter_workspace_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flex_service_instance_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chat_service_instance_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"messaging_service_instance_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"runtime_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ui_language": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ui_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFlexConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).FlexExtensions
+
+	getResponse, err := client.Configuration().FetchWithContext(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to read flex configuration: %s", err.Error())
+	}
+
+	setFlexConfiguration(d, getResponse)
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package helper
+
+import (
+	"encoding/json"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ChangedJSONValue unmarshals the JSON string of the key when the value has changed, so only the configuration sections which are managed by Terraform are sent to the API
+func ChangedJSONValue(d *schema.ResourceData, key string) *interface{} {
+	if !d.HasChange(key) {
+		return nil
+	}
+
+	v, ok := d.GetOk(key)
+	if !ok {
+		return nil
+	}
+
+	var value interface{}
+	// error not handled as it is assumed stringIsJSON validation is applied to the resource
+	json.Unmarshal([]byte(v.(string)), &value)
+	return &value
+}
+
+// ChangedString returns the value of the key when the value has changed, so only the configuration sections which are managed by Terraform are sent to the API
+func ChangedString(d *schema.ResourceData, key string) *string {
+	if !d.HasChange(key) {
+		return nil
+	}
+	return sdkUtils.String(d.Get(key).(string))
+}
+
+// ChangedBool returns the value of the key when the value has changed, so only the configuration sections which are managed by Terraform are sent to the API.
+// When the resource is being created the value is returned if it has been set, as a false value is not seen as a change
+func ChangedBool(d *schema.ResourceData, key string) *bool {
+	if d.IsNewResource() {
+		return utils.OptionalBool(d, key)
+	}
+	if !d.HasChange(key) {
+		return nil
+	}
+	return sdkUtils.Bool(d.Get(key).(bool))
+}
+
+// FlattenJSONValue marshals a configuration section returned by the API into a JSON string
+func FlattenJSONValue(value *interface{}) *string {
+	if value == nil || *value == nil {
+		return nil
+	}
+
+	jsonBytes, err := json.Marshal(*value)
+	if err != nil {
+		return nil
+	}
+	return sdkUtils.String(string(jsonBytes))
+}
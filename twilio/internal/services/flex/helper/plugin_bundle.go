@@ -0,0 +1,239 @@
+package helper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// PluginBundleFile is a file which is uploaded as part of a plugin bundle
+type PluginBundleFile struct {
+	SourcePath   string
+	RelativePath string
+}
+
+// PluginBundle is a built Flex plugin which is hosted as serverless assets
+type PluginBundle struct {
+	Files     []PluginBundleFile
+	EntryFile string
+	Hash      string
+}
+
+// PluginBundleSourceExists returns whether the source exists, so the plan can be created before the plugin has been built
+func PluginBundleSourceExists(source string) (bool, error) {
+	sourcePath, err := homedir.Expand(source)
+	if err != nil {
+		return false, fmt.Errorf("Error expanding homedir: %s", err.Error())
+	}
+
+	if _, err := os.Stat(sourcePath); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error reading source: %s", err.Error())
+	}
+	return true, nil
+}
+
+// LoadPluginBundle reads the plugin bundle from the source, which can either be a JavaScript file or a directory of built plugin files.
+// When the source is a directory, the entry file must be supplied unless there is only one JavaScript file in the root of the directory.
+// The hash is calculated from the relative path and content of each file, so the bundle is only redeployed when the content changes
+func LoadPluginBundle(source string, entryFile string) (*PluginBundle, error) {
+	sourcePath, err := homedir.Expand(source)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir: %s", err.Error())
+	}
+
+	info, err := os.Stat(sourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source: %s", err.Error())
+	}
+
+	bundle := &PluginBundle{
+		Files: []PluginBundleFile{},
+	}
+
+	if !info.IsDir() {
+		if !strings.HasSuffix(sourcePath, ".js") {
+			return nil, fmt.Errorf("The source (%s) must be a JavaScript file or a directory", source)
+		}
+		if entryFile != "" {
+			return nil, fmt.Errorf("The entry file can only be set when the source is a directory")
+		}
+
+		bundle.Files = append(bundle.Files, PluginBundleFile{
+			SourcePath:   sourcePath,
+			RelativePath: filepath.Base(sourcePath),
+		})
+		bundle.EntryFile = filepath.Base(sourcePath)
+	} else {
+		err := filepath.WalkDir(sourcePath, func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !entry.Type().IsRegular() {
+				return nil
+			}
+
+			relativePath, err := filepath.Rel(sourcePath, filePath)
+			if err != nil {
+				return err
+			}
+
+			bundle.Files = append(bundle.Files, PluginBundleFile{
+				SourcePath:   filePath,
+				RelativePath: filepath.ToSlash(relativePath),
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error reading source directory: %s", err.Error())
+		}
+
+		sort.Slice(bundle.Files, func(i, j int) bool {
+			return bundle.Files[i].RelativePath < bundle.Files[j].RelativePath
+		})
+
+		entry, err := pluginBundleEntryFile(bundle.Files, entryFile)
+		if err != nil {
+			return nil, err
+		}
+		bundle.EntryFile = entry
+	}
+
+	hash, err := hashPluginBundleFiles(bundle.Files)
+	if err != nil {
+		return nil, err
+	}
+	bundle.Hash = hash
+
+	return bundle, nil
+}
+
+func pluginBundleEntryFile(files []PluginBundleFile, entryFile string) (string, error) {
+	if entryFile != "" {
+		for _, file := range files {
+			if file.RelativePath == entryFile {
+				return entryFile, nil
+			}
+		}
+		return "", fmt.Errorf("The entry file (%s) was not found in the source directory", entryFile)
+	}
+
+	rootJavaScriptFiles := []string{}
+	for _, file := range files {
+		if !strings.Contains(file.RelativePath, "/") && strings.HasSuffix(file.RelativePath, ".js") {
+			rootJavaScriptFiles = append(rootJavaScriptFiles, file.RelativePath)
+		}
+	}
+
+	if len(rootJavaScriptFiles) != 1 {
+		return "", fmt.Errorf("Expected 1 JavaScript file in the root of the source directory but found %d. Set the entry file to choose the plugin bundle", len(rootJavaScriptFiles))
+	}
+	return rootJavaScriptFiles[0], nil
+}
+
+func hashPluginBundleFiles(files []PluginBundleFile) (string, error) {
+	hash := sha256.New()
+	for _, file := range files {
+		hash.Write([]byte(file.RelativePath))
+		hash.Write([]byte{0})
+
+		content, err := os.Open(file.SourcePath)
+		if err != nil {
+			return "", fmt.Errorf("Error opening file (%s): %s", file.RelativePath, err.Error())
+		}
+		_, err = io.Copy(hash, content)
+		content.Close()
+		if err != nil {
+			return "", fmt.Errorf("Error reading file (%s): %s", file.RelativePath, err.Error())
+		}
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// AssetPath returns the serverless asset path of the file. The path contains the bundle hash so each version of the bundle is hosted at a unique URL
+func (bundle PluginBundle) AssetPath(uniqueName string, relativePath string) string {
+	return path.Join("/plugins", uniqueName, bundle.Hash[:16], relativePath)
+}
+
+// PluginBundleContentType returns the content type of the file based on the file extension
+func PluginBundleContentType(relativePath string) string {
+	if strings.HasSuffix(relativePath, ".js") {
+		return "application/javascript"
+	}
+	if contentType := mime.TypeByExtension(path.Ext(relativePath)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+// PluginBundleAssetVersion is an asset version of a previously deployed plugin bundle
+type PluginBundleAssetVersion struct {
+	Sid         string
+	Path        string
+	DateCreated time.Time
+}
+
+// RetainedAssetVersions returns the paths and SIDs of the asset versions which belong to the most recently deployed previous bundles, up to the retained count.
+// The asset versions of each bundle share the same directory, as the asset path contains the bundle hash. The asset versions of the current bundle are excluded as these are uploaded again
+func (bundle PluginBundle) RetainedAssetVersions(uniqueName string, assetVersions []PluginBundleAssetVersion, retained int) map[string]string {
+	bundlePath := func(assetPath string) string {
+		segments := strings.SplitN(strings.TrimPrefix(assetPath, "/"), "/", 4)
+		if len(segments) < 4 {
+			return assetPath
+		}
+		return "/" + path.Join(segments[:3]...)
+	}
+
+	currentBundlePath := path.Join("/plugins", uniqueName, bundle.Hash[:16])
+	bundleDates := make(map[string]time.Time)
+	for _, assetVersion := range assetVersions {
+		previousBundlePath := bundlePath(assetVersion.Path)
+		if previousBundlePath == currentBundlePath {
+			continue
+		}
+		if assetVersion.DateCreated.After(bundleDates[previousBundlePath]) {
+			bundleDates[previousBundlePath] = assetVersion.DateCreated
+		}
+	}
+
+	previousBundlePaths := []string{}
+	for previousBundlePath := range bundleDates {
+		previousBundlePaths = append(previousBundlePaths, previousBundlePath)
+	}
+	sort.Slice(previousBundlePaths, func(i, j int) bool {
+		if bundleDates[previousBundlePaths[i]].Equal(bundleDates[previousBundlePaths[j]]) {
+			return previousBundlePaths[i] < previousBundlePaths[j]
+		}
+		return bundleDates[previousBundlePaths[i]].After(bundleDates[previousBundlePaths[j]])
+	})
+
+	retainedBundlePaths := make(map[string]bool)
+	for index, previousBundlePath := range previousBundlePaths {
+		if index >= retained {
+			break
+		}
+		retainedBundlePaths[previousBundlePath] = true
+	}
+
+	assetVersionPaths := make(map[string]string)
+	for _, assetVersion := range assetVersions {
+		if retainedBundlePaths[bundlePath(assetVersion.Path)] {
+			assetVersionPaths[assetVersion.Path] = assetVersion.Sid
+		}
+	}
+	return assetVersionPaths
+}
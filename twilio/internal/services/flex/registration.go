@@ -12,6 +12,8 @@ func (r Registration) Name() string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
+		"twilio_flex_active_release":       dataSourceFlexActiveRelease(),
+		"twilio_flex_configuration":        dataSourceFlexConfiguration(),
 		"twilio_flex_flow":                 dataSourceFlexFlow(),
 		"twilio_flex_plugin":               dataSourceFlexPlugin(),
 		"twilio_flex_plugin_configuration": dataSourceFlexPluginConfiguration(),
@@ -22,9 +24,13 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"twilio_flex_flow":                 resourceFlexFlow(),
-		"twilio_flex_plugin":               resourceFlexPlugin(),
-		"twilio_flex_plugin_configuration": resourceFlexPluginConfiguration(),
-		"twilio_flex_plugin_release":       resourceFlexPluginRelease(),
+		"twilio_flex_configuration":                       resourceFlexConfiguration(),
+		"twilio_flex_conversations_address_configuration": resourceFlexConversationsAddressConfiguration(),
+		"twilio_flex_conversations_web_chat":              resourceFlexConversationsWebChat(),
+		"twilio_flex_flow":                                resourceFlexFlow(),
+		"twilio_flex_plugin":                              resourceFlexPlugin(),
+		"twilio_flex_plugin_bundle":                       resourceFlexPluginBundle(),
+		"twilio_flex_plugin_configuration":                resourceFlexPluginConfiguration(),
+		"twilio_flex_plugin_release":                      resourceFlexPluginRelease(),
 	}
 }
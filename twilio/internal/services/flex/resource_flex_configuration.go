@@ -0,0 +1,266 @@
+package flex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/flex/v1/configuration"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/flex/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFlexConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFlexConfigurationCreate,
+		ReadContext:   resourceFlexConfigurationRead,
+		UpdateContext: resourceFlexConfigurationUpdate,
+		DeleteContext: resourceFlexConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				format := "/Configuration"
+
+				if d.Id() != format {
+					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
+				}
+
+				d.SetId(meta.(*common.TwilioClient).AccountSid)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"ui_attributes": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"queue_stats_configuration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"taskrouter_skills": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"taskrouter_target_workflow_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: utils.TaskRouterWorkflowSidValidation(),
+			},
+			"taskrouter_target_taskqueue_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: utils.TaskRouterTaskQueueSidValidation(),
+			},
+			"taskrouter_offline_activity_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: utils.TaskRouterActivitySidValidation(),
+			},
+			"crm_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"crm_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"crm_callback_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"crm_fallback_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"crm_attributes": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"taskrouter_workspace_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flex_service_instance_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chat_service_instance_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"messaging_service_instance_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"runtime_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ui_language": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ui_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFlexConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The Flex configuration already exists for the account so updating the configuration
+	return resourceFlexConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceFlexConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).FlexExtensions
+
+	getResponse, err := client.Configuration().FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read flex configuration: %s", err.Error())
+	}
+
+	setFlexConfiguration(d, getResponse)
+
+	return nil
+}
+
+func resourceFlexConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	twilioClient := meta.(*common.TwilioClient)
+	client := twilioClient.FlexExtensions
+
+	updateInput := &configuration.UpdateConfigurationInput{
+		AccountSid:                   twilioClient.AccountSid,
+		Attributes:                   helper.ChangedJSONValue(d, "attributes"),
+		CrmAttributes:                helper.ChangedJSONValue(d, "crm_attributes"),
+		CrmCallbackURL:               helper.ChangedString(d, "crm_callback_url"),
+		CrmEnabled:                   helper.ChangedBool(d, "crm_enabled"),
+		CrmFallbackURL:               helper.ChangedString(d, "crm_fallback_url"),
+		CrmType:                      helper.ChangedString(d, "crm_type"),
+		QueueStatsConfiguration:      helper.ChangedJSONValue(d, "queue_stats_configuration"),
+		TaskRouterOfflineActivitySid: helper.ChangedString(d, "taskrouter_offline_activity_sid"),
+		TaskRouterSkills:             helper.ChangedJSONValue(d, "taskrouter_skills"),
+		TaskRouterTargetTaskQueueSid: helper.ChangedString(d, "taskrouter_target_taskqueue_sid"),
+		TaskRouterTargetWorkflowSid:  helper.ChangedString(d, "taskrouter_target_workflow_sid"),
+		UiAttributes:                 helper.ChangedJSONValue(d, "ui_attributes"),
+	}
+
+	updateResponse, err := client.Configuration().UpdateWithContext(ctx, updateInput)
+	if err != nil {
+		return diag.Errorf("Failed to update flex configuration: %s", err.Error())
+	}
+
+	d.SetId(updateResponse.AccountSid)
+	return resourceFlexConfigurationRead(ctx, d, meta)
+}
+
+func resourceFlexConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Flex configuration cannot be deleted, so removing from the Terraform state")
+
+	d.SetId("")
+	return nil
+}
+
+func setFlexConfiguration(d *schema.ResourceData, getResponse *configuration.FetchConfigurationResponse) {
+	d.SetId(getResponse.AccountSid)
+	d.Set("account_sid", getResponse.AccountSid)
+	d.Set("attributes", helper.FlattenJSONValue(getResponse.Attributes))
+	d.Set("ui_attributes", helper.FlattenJSONValue(getResponse.UiAttributes))
+	d.Set("queue_stats_configuration", helper.FlattenJSONValue(getResponse.QueueStatsConfiguration))
+	d.Set("taskrouter_skills", helper.FlattenJSONValue(getResponse.TaskRouterSkills))
+	d.Set("taskrouter_target_workflow_sid", getResponse.TaskRouterTargetWorkflowSid)
+	d.Set("taskrouter_target_taskqueue_sid", getResponse.TaskRouterTargetTaskQueueSid)
+	d.Set("taskrouter_offline_activity_sid", getResponse.TaskRouterOfflineActivitySid)
+	d.Set("taskrouter_workspace_sid", getResponse.TaskRouterWorkspaceSid)
+	d.Set("crm_enabled", getResponse.CrmEnabled)
+	d.Set("crm_type", getResponse.CrmType)
+	d.Set("crm_callback_url", getResponse.CrmCallbackURL)
+	d.Set("crm_fallback_url", getResponse.CrmFallbackURL)
+	d.Set("crm_attributes", helper.FlattenJSONValue(getResponse.CrmAttributes))
+	d.Set("flex_service_instance_sid", getResponse.FlexServiceInstanceSid)
+	d.Set("chat_service_instance_sid", getResponse.ChatServiceInstanceSid)
+	d.Set("messaging_service_instance_sid", getResponse.MessagingServiceInstanceSid)
+	d.Set("runtime_domain", getResponse.RuntimeDomain)
+	d.Set("service_version", getResponse.ServiceVersion)
+	d.Set("status", getResponse.Status)
+	d.Set("ui_language", getResponse.UiLanguage)
+	d.Set("ui_version", getResponse.UiVersion)
+	d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
+
+	if getResponse.DateUpdated != nil {
+		d.Set("date_updated", getResponse.DateUpdated.Format(time.RFC3339))
+	}
+
+	d.Set("url", getResponse.URL)
+}
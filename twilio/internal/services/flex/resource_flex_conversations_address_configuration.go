@@ -0,0 +1,63 @@
+package flex
+
+import (
+	"context"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/conversations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The flex conversations address configuration is a studio address configuration which defaults to the conversations service of the Flex instance
+// and supports all of the address types which can be routed to Flex
+func resourceFlexConversationsAddressConfiguration() *schema.Resource {
+	resource := flexConversationsStudioAddressConfigurationResource()
+	resource.Schema["type"].ValidateFunc = validation.StringInSlice([]string{
+		"sms",
+		"whatsapp",
+		"messenger",
+		"gbm",
+		"email",
+		"rcs",
+		"apple",
+		"chat",
+	}, false)
+	return resource
+}
+
+func flexConversationsStudioAddressConfigurationResource() *schema.Resource {
+	resource := conversations.ResourceConversationsAddressConfigurationStudio()
+
+	resource.Schema["service_sid"].Computed = true
+	resource.Schema["retry_count"].Required = false
+	resource.Schema["retry_count"].Optional = true
+	resource.Schema["retry_count"].Default = 3
+
+	createContext := resource.CreateContext
+	resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if _, ok := d.GetOk("service_sid"); !ok {
+			serviceSid, err := flexConversationsServiceSid(ctx, meta)
+			if err != nil {
+				return err
+			}
+			d.Set("service_sid", serviceSid)
+		}
+		return createContext(ctx, d, meta)
+	}
+
+	return resource
+}
+
+// Flex Conversations interactions are created in the conversations service which is associated with the Flex instance
+func flexConversationsServiceSid(ctx context.Context, meta interface{}) (string, diag.Diagnostics) {
+	getConfigurationResponse, err := meta.(*common.TwilioClient).FlexExtensions.Configuration().FetchWithContext(ctx)
+	if err != nil {
+		return "", diag.Errorf("Failed to read flex configuration: %s", err.Error())
+	}
+	if getConfigurationResponse.FlexServiceInstanceSid == nil || *getConfigurationResponse.FlexServiceInstanceSid == "" {
+		return "", diag.Errorf("The flex configuration does not have a flex service instance sid. Please set the service_sid")
+	}
+	return *getConfigurationResponse.FlexServiceInstanceSid, nil
+}
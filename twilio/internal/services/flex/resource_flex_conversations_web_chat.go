@@ -0,0 +1,26 @@
+package flex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The flex conversations web chat is the chat address configuration which web chat conversations are created against and routed to Flex with
+func resourceFlexConversationsWebChat() *schema.Resource {
+	resource := flexConversationsStudioAddressConfigurationResource()
+
+	resource.Schema["type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	createContext := resource.CreateContext
+	resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		d.Set("type", "chat")
+		return createContext(ctx, d, meta)
+	}
+
+	return resource
+}
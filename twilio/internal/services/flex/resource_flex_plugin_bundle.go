@@ -0,0 +1,438 @@
+package flex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/flex/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	serverless "github.com/RJPearson94/twilio-sdk-go/service/serverless/v1"
+	"github.com/RJPearson94/twilio-sdk-go/service/serverless/v1/service/asset/versions"
+	"github.com/RJPearson94/twilio-sdk-go/service/serverless/v1/service/assets"
+	"github.com/RJPearson94/twilio-sdk-go/service/serverless/v1/service/builds"
+	"github.com/RJPearson94/twilio-sdk-go/service/serverless/v1/service/environment/deployments"
+	"github.com/RJPearson94/twilio-sdk-go/service/serverless/v1/service/environments"
+	"github.com/RJPearson94/twilio-sdk-go/service/serverless/v1/services"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const pluginBundleEnvironmentUniqueName = "plugins"
+
+// pluginBundleRetainedBundles is the number of previously deployed bundles which are included in each new build, so recent plugin versions can continue to be served
+const pluginBundleRetainedBundles = 5
+
+func resourceFlexPluginBundle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFlexPluginBundleCreate,
+		ReadContext:   resourceFlexPluginBundleRead,
+		UpdateContext: resourceFlexPluginBundleUpdate,
+		DeleteContext: resourceFlexPluginBundleDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unique_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"service_unique_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"entry_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"service_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"build_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plugin_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// The source may be the output of another resource or may not have been built yet, so the hash derived attributes are calculated when the bundle is deployed
+			if !d.NewValueKnown("source") || !d.NewValueKnown("entry_file") {
+				return setPluginBundleNewComputed(d)
+			}
+
+			sourceExists, err := helper.PluginBundleSourceExists(d.Get("source").(string))
+			if err != nil {
+				return err
+			}
+			if !sourceExists {
+				log.Printf("[INFO] The flex plugin bundle source (%s) does not exist, so the bundle will be loaded when the changes are applied", d.Get("source").(string))
+				return setPluginBundleNewComputed(d)
+			}
+
+			bundle, err := helper.LoadPluginBundle(d.Get("source").(string), d.Get("entry_file").(string))
+			if err != nil {
+				return err
+			}
+
+			oldHash, _ := d.GetChange("source_hash")
+			if oldHash.(string) == bundle.Hash && !d.HasChange("entry_file") {
+				return nil
+			}
+
+			if err := d.SetNew("source_hash", bundle.Hash); err != nil {
+				return err
+			}
+			if err := d.SetNew("revision", d.Get("revision").(int)+1); err != nil {
+				return err
+			}
+
+			// The domain name is only known once the environment has been created
+			if domainName := d.Get("domain_name").(string); domainName != "" && !d.HasChange("unique_name") {
+				if err := d.SetNew("plugin_url", "https://"+domainName+bundle.AssetPath(d.Get("unique_name").(string), bundle.EntryFile)); err != nil {
+					return err
+				}
+			} else {
+				if err := d.SetNewComputed("plugin_url"); err != nil {
+					return err
+				}
+			}
+
+			for _, key := range []string{"build_sid", "deployment_sid"} {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func setPluginBundleNewComputed(d *schema.ResourceDiff) error {
+	for _, key := range []string{"source_hash", "revision", "plugin_url", "build_sid", "deployment_sid"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFlexPluginBundleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Serverless
+
+	bundle, err := loadPlannedPluginBundle(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceUniqueName := d.Get("unique_name").(string)
+	if value, ok := d.GetOk("service_unique_name"); ok {
+		serviceUniqueName = value.(string)
+	}
+
+	createServiceResult, err := client.Services.CreateWithContext(ctx, &services.CreateServiceInput{
+		FriendlyName: fmt.Sprintf("Flex plugin bundle (%s)", d.Get("unique_name").(string)),
+		UniqueName:   serviceUniqueName,
+		UiEditable:   sdkUtils.Bool(false),
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create serverless service for flex plugin bundle: %s", err.Error())
+	}
+
+	d.SetId(createServiceResult.Sid)
+
+	createEnvironmentResult, err := client.Service(d.Id()).Environments.CreateWithContext(ctx, &environments.CreateEnvironmentInput{
+		UniqueName: pluginBundleEnvironmentUniqueName,
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create serverless environment for flex plugin bundle: %s", err.Error())
+	}
+
+	d.Set("environment_sid", createEnvironmentResult.Sid)
+	d.Set("domain_name", createEnvironmentResult.DomainName)
+
+	if err := deployPluginBundle(ctx, d, client, bundle); err != nil {
+		return err
+	}
+
+	return resourceFlexPluginBundleRead(ctx, d, meta)
+}
+
+func resourceFlexPluginBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Serverless
+
+	getServiceResponse, err := client.Service(d.Id()).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read serverless service for flex plugin bundle: %s", err.Error())
+	}
+
+	d.Set("account_sid", getServiceResponse.AccountSid)
+	d.Set("service_sid", getServiceResponse.Sid)
+	d.Set("service_unique_name", getServiceResponse.UniqueName)
+
+	getEnvironmentResponse, err := client.Service(d.Id()).Environment(d.Get("environment_sid").(string)).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			log.Printf("[INFO] Serverless environment (%s) for flex plugin bundle was not found, so the plugin bundle will be recreated", d.Get("environment_sid").(string))
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read serverless environment for flex plugin bundle: %s", err.Error())
+	}
+
+	d.Set("environment_sid", getEnvironmentResponse.Sid)
+	d.Set("domain_name", getEnvironmentResponse.DomainName)
+	d.Set("build_sid", getEnvironmentResponse.BuildSid)
+
+	return nil
+}
+
+func resourceFlexPluginBundleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Serverless
+
+	if d.HasChanges("source_hash", "entry_file") {
+		bundle, err := loadPlannedPluginBundle(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// When the hash could not be calculated during the plan, the bundle is only deployed if the content has changed
+		if previousHash, _ := d.GetChange("source_hash"); previousHash.(string) == bundle.Hash && !d.HasChange("entry_file") {
+			log.Printf("[INFO] The content of the flex plugin bundle (%s) has not changed, so the bundle will not be deployed", d.Get("unique_name").(string))
+			previousRevision, _ := d.GetChange("revision")
+			previousPluginURL, _ := d.GetChange("plugin_url")
+			previousDeploymentSid, _ := d.GetChange("deployment_sid")
+			d.Set("source_hash", bundle.Hash)
+			d.Set("revision", previousRevision)
+			d.Set("plugin_url", previousPluginURL)
+			d.Set("deployment_sid", previousDeploymentSid)
+		} else if err := deployPluginBundle(ctx, d, client, bundle); err != nil {
+			return err
+		}
+	} else {
+		log.Printf("[INFO] The content of the flex plugin bundle (%s) has not changed, so the bundle will not be deployed", d.Get("unique_name").(string))
+	}
+
+	return resourceFlexPluginBundleRead(ctx, d, meta)
+}
+
+func resourceFlexPluginBundleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Serverless
+
+	if err := client.Service(d.Id()).DeleteWithContext(ctx); err != nil {
+		return diag.Errorf("Failed to delete serverless service for flex plugin bundle: %s", err.Error())
+	}
+	d.SetId("")
+	return nil
+}
+
+// loadPlannedPluginBundle reloads the bundle and ensures the content has not changed since the plan was created, as the plugin URL is calculated from the planned content hash
+func loadPlannedPluginBundle(d *schema.ResourceData) (*helper.PluginBundle, error) {
+	bundle, err := helper.LoadPluginBundle(d.Get("source").(string), d.Get("entry_file").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	if plannedHash := d.Get("source_hash").(string); plannedHash != "" && plannedHash != bundle.Hash {
+		return nil, fmt.Errorf("The content of the source (%s) has changed since the plan was created. Please re-run terraform to deploy the latest content", d.Get("source").(string))
+	}
+	return bundle, nil
+}
+
+// deployPluginBundle uploads each file of the bundle as a protected asset, then builds and deploys the assets to the plugin bundle environment.
+// The asset versions of the most recent previous bundles are retained so previous plugin versions continue to be served and can be rolled back to
+func deployPluginBundle(ctx context.Context, d *schema.ResourceData, client *serverless.Serverless, bundle *helper.PluginBundle) diag.Diagnostics {
+	serviceClient := client.Service(d.Id())
+	uniqueName := d.Get("unique_name").(string)
+
+	assetsPaginator := serviceClient.Assets.NewAssetsPaginator()
+	for assetsPaginator.NextWithContext(ctx) {
+	}
+	if err := assetsPaginator.Error(); err != nil {
+		return diag.Errorf("Failed to read serverless assets for flex plugin bundle: %s", err.Error())
+	}
+
+	assetSids := make(map[string]string)
+	for _, asset := range assetsPaginator.Assets {
+		assetSids[asset.FriendlyName] = asset.Sid
+	}
+
+	assetVersionPaths := make(map[string]string)
+	// The build sid is marked as computed in the plan so the previously deployed build sid is retrieved from the state
+	if previousBuildSid, _ := d.GetChange("build_sid"); previousBuildSid.(string) != "" && !d.IsNewResource() {
+		buildSid := previousBuildSid.(string)
+		getBuildResponse, err := serviceClient.Build(buildSid).FetchWithContext(ctx)
+		if err != nil && !utils.IsNotFoundError(err) {
+			return diag.Errorf("Failed to read previous serverless build for flex plugin bundle: %s", err.Error())
+		}
+		if err == nil && getBuildResponse.AssetVersions != nil {
+			previousAssetVersions := []helper.PluginBundleAssetVersion{}
+			for _, assetVersion := range *getBuildResponse.AssetVersions {
+				previousAssetVersions = append(previousAssetVersions, helper.PluginBundleAssetVersion{
+					Sid:         assetVersion.Sid,
+					Path:        assetVersion.Path,
+					DateCreated: assetVersion.DateCreated,
+				})
+			}
+			assetVersionPaths = bundle.RetainedAssetVersions(uniqueName, previousAssetVersions, pluginBundleRetainedBundles)
+		}
+	}
+
+	for _, file := range bundle.Files {
+		assetSid, ok := assetSids[file.RelativePath]
+		if !ok {
+			createAssetResult, err := serviceClient.Assets.CreateWithContext(ctx, &assets.CreateAssetInput{
+				FriendlyName: file.RelativePath,
+			})
+			if err != nil {
+				return diag.Errorf("Failed to create serverless asset for flex plugin bundle file (%s): %s", file.RelativePath, err.Error())
+			}
+			assetSid = createAssetResult.Sid
+			assetSids[file.RelativePath] = assetSid
+		}
+
+		path := bundle.AssetPath(uniqueName, file.RelativePath)
+		assetVersionSid, err := createPluginBundleAssetVersion(ctx, client, d.Id(), assetSid, path, file)
+		if err != nil {
+			return err
+		}
+		assetVersionPaths[path] = assetVersionSid
+	}
+
+	assetVersionSids := make([]string, 0)
+	for _, assetVersionSid := range assetVersionPaths {
+		assetVersionSids = append(assetVersionSids, assetVersionSid)
+	}
+
+	createBuildResult, err := serviceClient.Builds.CreateWithContext(ctx, &builds.CreateBuildInput{
+		AssetVersions: &assetVersionSids,
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create serverless build for flex plugin bundle: %s", err.Error())
+	}
+
+	if err := pollPluginBundleBuild(ctx, client, d.Id(), createBuildResult.Sid); err != nil {
+		return err
+	}
+
+	createDeploymentResult, err := serviceClient.Environment(d.Get("environment_sid").(string)).Deployments.CreateWithContext(ctx, &deployments.CreateDeploymentInput{
+		BuildSid: sdkUtils.String(createBuildResult.Sid),
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create serverless deployment for flex plugin bundle: %s", err.Error())
+	}
+
+	// The revision is unknown when the hash could not be calculated during the plan
+	if previousRevision, plannedRevision := d.GetChange("revision"); plannedRevision.(int) == 0 {
+		d.Set("revision", previousRevision.(int)+1)
+	}
+	d.Set("source_hash", bundle.Hash)
+	d.Set("build_sid", createBuildResult.Sid)
+	d.Set("deployment_sid", createDeploymentResult.Sid)
+	d.Set("plugin_url", "https://"+d.Get("domain_name").(string)+bundle.AssetPath(uniqueName, bundle.EntryFile))
+
+	return nil
+}
+
+func createPluginBundleAssetVersion(ctx context.Context, client *serverless.Serverless, serviceSid string, assetSid string, path string, file helper.PluginBundleFile) (string, diag.Diagnostics) {
+	body, err := os.Open(file.SourcePath)
+	if err != nil {
+		return "", diag.Errorf("Error opening flex plugin bundle file (%s): %s", file.RelativePath, err.Error())
+	}
+
+	defer func() {
+		err := body.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing flex plugin bundle file (%s): %s", file.RelativePath, err.Error())
+		}
+	}()
+
+	createResult, err := client.Service(serviceSid).Asset(assetSid).Versions.CreateWithContext(ctx, &versions.CreateVersionInput{
+		Content: versions.CreateContentDetails{
+			Body:        body,
+			ContentType: helper.PluginBundleContentType(file.RelativePath),
+			FileName:    file.RelativePath,
+		},
+		Path:       path,
+		Visibility: "protected",
+	})
+	if err != nil {
+		return "", diag.Errorf("Failed to create serverless asset version for flex plugin bundle file (%s): %s", file.RelativePath, err.Error())
+	}
+	return createResult.Sid, nil
+}
+
+func pollPluginBundleBuild(ctx context.Context, client *serverless.Serverless, serviceSid string, buildSid string) diag.Diagnostics {
+	for {
+		getResponse, err := client.Service(serviceSid).Build(buildSid).Status().FetchWithContext(ctx)
+		if err != nil {
+			return diag.Errorf("Failed to poll serverless build for flex plugin bundle: %s", err.Error())
+		}
+
+		if getResponse.Status == "failed" {
+			return diag.Errorf("Serverless build (%s) for flex plugin bundle failed", buildSid)
+		}
+		if getResponse.Status == "completed" {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return diag.Errorf("Timed out waiting for serverless build (%s) for flex plugin bundle to complete", buildSid)
+		case <-time.After(1 * time.Second):
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	flex "github.com/RJPearson94/twilio-sdk-go/service/flex/v1"
 	"github.com/RJPearson94/twilio-sdk-go/service/flex/v1/plugin_configurations"
 	"github.com/RJPearson94/twilio-sdk-go/service/flex/v1/plugin_releases"
 	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
@@ -20,6 +21,7 @@ func resourceFlexPluginRelease() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceFlexPluginReleaseCreate,
 		ReadContext:   resourceFlexPluginReleaseRead,
+		UpdateContext: resourceFlexPluginReleaseUpdate,
 		DeleteContext: resourceFlexPluginReleaseDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -33,6 +35,7 @@ func resourceFlexPluginRelease() *schema.Resource {
 				}
 
 				d.Set("sid", match[1])
+				d.Set("restore_previous_release", false)
 				d.SetId(match[1])
 				return []*schema.ResourceData{d}, nil
 			},
@@ -41,6 +44,7 @@ func resourceFlexPluginRelease() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -59,6 +63,19 @@ func resourceFlexPluginRelease() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: utils.FlexPluginConfigurationSidValidation(),
 			},
+			"restore_previous_release": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"previous_release_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_configuration_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -72,12 +89,25 @@ func resourceFlexPluginRelease() *schema.Resource {
 }
 
 func resourceFlexPluginReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Flex
+
+	previousRelease, _, err := fetchActiveReleases(ctx, client)
+	if err != nil {
+		return diag.Errorf("Failed to read active flex plugin release: %s", err.Error())
+	}
+
 	createResult, err := createRelease(ctx, d, meta, d.Get("configuration_sid").(string))
 	if err != nil {
 		return diag.Errorf("Failed to create flex plugin release: %s", err.Error())
 	}
 
 	d.SetId(createResult.Sid)
+
+	if previousRelease != nil {
+		d.Set("previous_release_sid", previousRelease.Sid)
+		d.Set("previous_configuration_sid", previousRelease.ConfigurationSid)
+	}
+
 	return resourceFlexPluginReleaseRead(ctx, d, meta)
 }
 
@@ -102,32 +132,39 @@ func resourceFlexPluginReleaseRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func resourceFlexPluginReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*common.TwilioClient).Flex
+func resourceFlexPluginReleaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Flex plugin releases cannot be updated. So only the restore previous release flag can be updated without a new resource being created")
 
-	releasesPaginator := client.PluginReleases.NewReleasesPaginatorWithOptions(&plugin_releases.ReleasesPageOptions{
-		PageSize: sdkUtils.Int(5),
-	})
+	return resourceFlexPluginReleaseRead(ctx, d, meta)
+}
 
-	// The twilio api return the latest version as the first element in the array.
-	// So there is no need to loop to retrieve all records
-	releasesPaginator.Next()
+func resourceFlexPluginReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Flex
 
-	if releasesPaginator.Error() != nil {
-		return diag.Errorf("Failed to read flex plugin releases: %s", releasesPaginator.Error().Error())
+	activeRelease, _, err := fetchActiveReleases(ctx, client)
+	if err != nil {
+		return diag.Errorf("Failed to read flex plugin releases: %s", err.Error())
 	}
 
-	isCurrentRelease := d.Id() == releasesPaginator.Releases[0].Sid
+	isCurrentRelease := activeRelease != nil && d.Id() == activeRelease.Sid
 	if isCurrentRelease {
-		log.Printf("[INFO] Flex plugin release is current so a new default configuration will be created with a new release")
-
-		defaultConfigResp, err := createDefaultConfiguration(ctx, d, meta)
-		if err != nil {
-			return diag.Errorf("Failed to create default configuration during release deletion: %s", err.Error())
-		}
-
-		if _, err := createRelease(ctx, d, meta, defaultConfigResp.Sid); err != nil {
-			return diag.Errorf("Failed to create new flex plugin release deletion: %s", err.Error())
+		if previousConfigurationSid := d.Get("previous_configuration_sid").(string); d.Get("restore_previous_release").(bool) && previousConfigurationSid != "" {
+			log.Printf("[INFO] Flex plugin release is current so the previous release (%s) will be restored with a new release", d.Get("previous_release_sid").(string))
+
+			if _, err := createRelease(ctx, d, meta, previousConfigurationSid); err != nil {
+				return diag.Errorf("Failed to restore previous flex plugin release during release deletion: %s", err.Error())
+			}
+		} else {
+			log.Printf("[INFO] Flex plugin release is current so a new default configuration will be created with a new release")
+
+			defaultConfigResp, err := createDefaultConfiguration(ctx, d, meta)
+			if err != nil {
+				return diag.Errorf("Failed to create default configuration during release deletion: %s", err.Error())
+			}
+
+			if _, err := createRelease(ctx, d, meta, defaultConfigResp.Sid); err != nil {
+				return diag.Errorf("Failed to create new flex plugin release deletion: %s", err.Error())
+			}
 		}
 	}
 
@@ -153,3 +190,27 @@ func createRelease(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	return client.PluginReleases.CreateWithContext(ctx, createInput)
 }
+
+// fetchActiveReleases returns the active release and the release which was active before it. Either release will be nil if it does not exist
+func fetchActiveReleases(ctx context.Context, client *flex.Flex) (*plugin_releases.PageReleaseResponse, *plugin_releases.PageReleaseResponse, error) {
+	releasesPaginator := client.PluginReleases.NewReleasesPaginatorWithOptions(&plugin_releases.ReleasesPageOptions{
+		PageSize: sdkUtils.Int(5),
+	})
+
+	// The twilio api return the latest version as the first element in the array.
+	// So there is no need to loop to retrieve all records
+	releasesPaginator.NextWithContext(ctx)
+
+	if releasesPaginator.Error() != nil {
+		return nil, nil, releasesPaginator.Error()
+	}
+
+	var activeRelease, previousRelease *plugin_releases.PageReleaseResponse
+	if len(releasesPaginator.Releases) > 0 {
+		activeRelease = &releasesPaginator.Releases[0]
+	}
+	if len(releasesPaginator.Releases) > 1 {
+		previousRelease = &releasesPaginator.Releases[1]
+	}
+	return activeRelease, previousRelease, nil
+}
@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var activeReleaseDataSourceName = "twilio_flex_active_release"
+
+func TestAccDataSourceTwilioFlexActiveRelease_basic(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.active_release", activeReleaseDataSourceName)
+	pluginReleaseStateResourceName := "twilio_flex_plugin_release.plugin_release"
+	pluginConfigurationStateResourceName := "twilio_flex_plugin_configuration.plugin_configuration"
+
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioFlexActiveRelease_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "sid", pluginReleaseStateResourceName, "sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "configuration_sid", pluginConfigurationStateResourceName, "sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "configuration_name", name),
+					resource.TestCheckResourceAttr(stateDataSourceName, "plugins.#", "1"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "plugins.0.plugin_version_sid", "twilio_flex_plugin.plugin", "latest_version_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "previous_release_sid", pluginReleaseStateResourceName, "previous_release_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "previous_configuration_sid", pluginReleaseStateResourceName, "previous_configuration_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "date_created"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioFlexActiveRelease_basic(name string) string {
+	return fmt.Sprintf(`
+resource "twilio_flex_plugin" "plugin" {
+  unique_name = "%[1]s"
+  version     = "1.0.0"
+  plugin_url  = "https://example.com"
+}
+
+resource "twilio_flex_plugin_configuration" "plugin_configuration" {
+  name = "%[1]s"
+  plugins {
+    plugin_version_sid = twilio_flex_plugin.plugin.latest_version_sid
+  }
+}
+
+resource "twilio_flex_plugin_release" "plugin_release" {
+  configuration_sid = twilio_flex_plugin_configuration.plugin_configuration.sid
+}
+
+data "twilio_flex_active_release" "active_release" {
+  depends_on = [twilio_flex_plugin_release.plugin_release]
+}
+`, name)
+}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var configurationDataSourceName = "twilio_flex_configuration"
+
+func TestAccDataSourceTwilioFlexConfiguration_basic(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.configuration", configurationDataSourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioFlexConfiguration_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "ui_attributes"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "taskrouter_workspace_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "flex_service_instance_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "status"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "date_created"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioFlexConfiguration_basic() string {
+	return `
+data "twilio_flex_configuration" "configuration" {}
+`
+}
@@ -0,0 +1,209 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/flex/helper"
+)
+
+func writePluginBundleFiles(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for relativePath, content := range files {
+		path := filepath.Join(directory, filepath.FromSlash(relativePath))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("err: %s", err.Error())
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("err: %s", err.Error())
+		}
+	}
+	return directory
+}
+
+func TestLoadPluginBundle_file(t *testing.T) {
+	directory := writePluginBundleFiles(t, map[string]string{
+		"plugin-sample.js": "console.log('v1')",
+	})
+
+	bundle, err := helper.LoadPluginBundle(filepath.Join(directory, "plugin-sample.js"), "")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	if bundle.EntryFile != "plugin-sample.js" {
+		t.Errorf("Expected entry file to be plugin-sample.js but got %s", bundle.EntryFile)
+	}
+	if len(bundle.Files) != 1 || bundle.Files[0].RelativePath != "plugin-sample.js" {
+		t.Errorf("Expected only plugin-sample.js to be included in the bundle but got %v", bundle.Files)
+	}
+	if !regexp.MustCompile(`^[0-9a-f]{64}$`).MatchString(bundle.Hash) {
+		t.Errorf("Expected hash to be a SHA256 hex digest but got %s", bundle.Hash)
+	}
+}
+
+func TestLoadPluginBundle_directory(t *testing.T) {
+	directory := writePluginBundleFiles(t, map[string]string{
+		"plugin-sample.js":     "console.log('v1')",
+		"plugin-sample.js.map": "{}",
+		"assets/logo.png":      "png",
+	})
+
+	bundle, err := helper.LoadPluginBundle(directory, "")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	if bundle.EntryFile != "plugin-sample.js" {
+		t.Errorf("Expected entry file to be plugin-sample.js but got %s", bundle.EntryFile)
+	}
+
+	relativePaths := []string{}
+	for _, file := range bundle.Files {
+		relativePaths = append(relativePaths, file.RelativePath)
+	}
+	expectedRelativePaths := []string{"assets/logo.png", "plugin-sample.js", "plugin-sample.js.map"}
+	if len(relativePaths) != len(expectedRelativePaths) {
+		t.Fatalf("Expected files %v but got %v", expectedRelativePaths, relativePaths)
+	}
+	for index, relativePath := range expectedRelativePaths {
+		if relativePaths[index] != relativePath {
+			t.Errorf("Expected files %v but got %v", expectedRelativePaths, relativePaths)
+		}
+	}
+
+	path := bundle.AssetPath("plugin-sample", bundle.EntryFile)
+	if path != "/plugins/plugin-sample/"+bundle.Hash[:16]+"/plugin-sample.js" {
+		t.Errorf("Unexpected asset path %s", path)
+	}
+}
+
+func TestLoadPluginBundle_hash(t *testing.T) {
+	first, err := helper.LoadPluginBundle(writePluginBundleFiles(t, map[string]string{"plugin-sample.js": "console.log('v1')"}), "")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	same, err := helper.LoadPluginBundle(writePluginBundleFiles(t, map[string]string{"plugin-sample.js": "console.log('v1')"}), "")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	changedContent, err := helper.LoadPluginBundle(writePluginBundleFiles(t, map[string]string{"plugin-sample.js": "console.log('v2')"}), "")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	renamed, err := helper.LoadPluginBundle(writePluginBundleFiles(t, map[string]string{"plugin-renamed.js": "console.log('v1')"}), "")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	if first.Hash != same.Hash {
+		t.Errorf("Expected the hash of identical bundles to match")
+	}
+	if first.Hash == changedContent.Hash {
+		t.Errorf("Expected the hash to change when the file content changes")
+	}
+	if first.Hash == renamed.Hash {
+		t.Errorf("Expected the hash to change when a file is renamed")
+	}
+}
+
+func TestLoadPluginBundle_entryFile(t *testing.T) {
+	directory := writePluginBundleFiles(t, map[string]string{
+		"plugin-sample.js": "console.log('plugin')",
+		"vendor.js":        "console.log('vendor')",
+	})
+
+	if _, err := helper.LoadPluginBundle(directory, ""); err == nil || !regexp.MustCompile(`Expected 1 JavaScript file in the root of the source directory but found 2`).MatchString(err.Error()) {
+		t.Errorf("Expected an error when the entry file is ambiguous but got %v", err)
+	}
+
+	bundle, err := helper.LoadPluginBundle(directory, "plugin-sample.js")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if bundle.EntryFile != "plugin-sample.js" {
+		t.Errorf("Expected entry file to be plugin-sample.js but got %s", bundle.EntryFile)
+	}
+
+	if _, err := helper.LoadPluginBundle(directory, "missing.js"); err == nil || !regexp.MustCompile(`The entry file \(missing.js\) was not found in the source directory`).MatchString(err.Error()) {
+		t.Errorf("Expected an error when the entry file does not exist but got %v", err)
+	}
+}
+
+func TestLoadPluginBundle_invalidSource(t *testing.T) {
+	directory := writePluginBundleFiles(t, map[string]string{
+		"README.md": "# Plugin",
+	})
+
+	if _, err := helper.LoadPluginBundle(filepath.Join(directory, "README.md"), ""); err == nil || !regexp.MustCompile(`must be a JavaScript file or a directory`).MatchString(err.Error()) {
+		t.Errorf("Expected an error when the source is not a JavaScript file but got %v", err)
+	}
+	if _, err := helper.LoadPluginBundle(filepath.Join(directory, "missing"), ""); err == nil {
+		t.Errorf("Expected an error when the source does not exist")
+	}
+}
+
+func TestPluginBundleSourceExists(t *testing.T) {
+	directory := writePluginBundleFiles(t, map[string]string{
+		"plugin-sample.js": "console.log('v1')",
+	})
+
+	if exists, err := helper.PluginBundleSourceExists(directory); err != nil || !exists {
+		t.Errorf("Expected the source directory to exist but got %t, %v", exists, err)
+	}
+	if exists, err := helper.PluginBundleSourceExists(filepath.Join(directory, "build")); err != nil || exists {
+		t.Errorf("Expected the missing source directory to not exist but got %t, %v", exists, err)
+	}
+}
+
+func TestPluginBundleRetainedAssetVersions(t *testing.T) {
+	bundle := helper.PluginBundle{
+		Hash: "cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc",
+	}
+	dateCreated := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assetVersions := []helper.PluginBundleAssetVersion{
+		{Sid: "ZN1", Path: "/plugins/plugin-sample/aaaaaaaaaaaaaaaa/plugin-sample.js", DateCreated: dateCreated},
+		{Sid: "ZN2", Path: "/plugins/plugin-sample/aaaaaaaaaaaaaaaa/plugin-sample.js.map", DateCreated: dateCreated},
+		{Sid: "ZN3", Path: "/plugins/plugin-sample/bbbbbbbbbbbbbbbb/plugin-sample.js", DateCreated: dateCreated.Add(time.Hour)},
+		{Sid: "ZN4", Path: "/plugins/plugin-sample/cccccccccccccccc/plugin-sample.js", DateCreated: dateCreated.Add(2 * time.Hour)},
+	}
+
+	expected := map[string]string{
+		"/plugins/plugin-sample/bbbbbbbbbbbbbbbb/plugin-sample.js": "ZN3",
+	}
+	if retained := bundle.RetainedAssetVersions("plugin-sample", assetVersions, 1); !reflect.DeepEqual(retained, expected) {
+		t.Errorf("Expected the asset versions of the most recent previous bundle %v but got %v", expected, retained)
+	}
+
+	expected = map[string]string{
+		"/plugins/plugin-sample/aaaaaaaaaaaaaaaa/plugin-sample.js":     "ZN1",
+		"/plugins/plugin-sample/aaaaaaaaaaaaaaaa/plugin-sample.js.map": "ZN2",
+		"/plugins/plugin-sample/bbbbbbbbbbbbbbbb/plugin-sample.js":     "ZN3",
+	}
+	if retained := bundle.RetainedAssetVersions("plugin-sample", assetVersions, 5); !reflect.DeepEqual(retained, expected) {
+		t.Errorf("Expected the asset versions of all previous bundles %v but got %v", expected, retained)
+	}
+
+	if retained := bundle.RetainedAssetVersions("plugin-sample", assetVersions, 0); len(retained) != 0 {
+		t.Errorf("Expected no asset versions to be retained but got %v", retained)
+	}
+}
+
+func TestPluginBundleContentType(t *testing.T) {
+	testCases := map[string]string{
+		"plugin-sample.js":     "application/javascript",
+		"plugin-sample.js.map": "application/octet-stream",
+		"assets/logo.png":      "image/png",
+	}
+
+	for relativePath, expected := range testCases {
+		if contentType := helper.PluginBundleContentType(relativePath); contentType != expected {
+			t.Errorf("Expected content type of %s to be %s but got %s", relativePath, expected, contentType)
+		}
+	}
+}
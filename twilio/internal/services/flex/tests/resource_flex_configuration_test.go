@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var configurationResourceName = "twilio_flex_configuration"
+
+func TestAccTwilioFlexConfiguration_basic(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.configuration", configurationResourceName)
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioFlexConfiguration_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateResourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "account_sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "attributes", fmt.Sprintf(`{"name":"%s"}`, name)),
+					resource.TestCheckResourceAttrSet(stateResourceName, "ui_attributes"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "taskrouter_workspace_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "status"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "date_created"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "url"),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateId:     "/Configuration",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexConfiguration_update(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.configuration", configurationResourceName)
+	name := acctest.RandString(10)
+	newName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioFlexConfiguration_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateResourceName, "attributes", fmt.Sprintf(`{"name":"%s"}`, name)),
+				),
+			},
+			{
+				Config: testAccTwilioFlexConfiguration_basic(newName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateResourceName, "attributes", fmt.Sprintf(`{"name":"%s"}`, newName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexConfiguration_invalidAttributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioFlexConfiguration_invalidAttributes(),
+				ExpectError: regexp.MustCompile(`(?s)"attributes" contains an invalid JSON`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexConfiguration_invalidTargetWorkflowSid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioFlexConfiguration_invalidTargetWorkflowSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of taskrouter_target_workflow_sid to match regular expression "\^WW\[0-9a-fA-F\]\{32\}\$", got taskrouter_target_workflow_sid`),
+			},
+		},
+	})
+}
+
+func testAccTwilioFlexConfiguration_basic(name string) string {
+	return fmt.Sprintf(`
+resource "twilio_flex_configuration" "configuration" {
+  attributes = jsonencode({
+    "name" : "%s"
+  })
+}
+`, name)
+}
+
+func testAccTwilioFlexConfiguration_invalidAttributes() string {
+	return `
+resource "twilio_flex_configuration" "configuration" {
+  attributes = "attributes"
+}
+`
+}
+
+func testAccTwilioFlexConfiguration_invalidTargetWorkflowSid() string {
+	return `
+resource "twilio_flex_configuration" "configuration" {
+  taskrouter_target_workflow_sid = "taskrouter_target_workflow_sid"
+}
+`
+}
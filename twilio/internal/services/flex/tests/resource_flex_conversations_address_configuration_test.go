@@ -0,0 +1,244 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var conversationsAddressConfigurationResourceName = "twilio_flex_conversations_address_configuration"
+
+func TestAccTwilioFlexConversationsAddressConfiguration_basic(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.address_configuration", conversationsAddressConfigurationResourceName)
+	address := acceptance.TestAccData.PhoneNumber
+	addressType := "sms"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioFlexConversationsAddressConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioFlexConversationsAddressConfiguration_basic(address, addressType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexConversationsAddressConfigurationExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "address", address),
+					resource.TestCheckResourceAttr(stateResourceName, "type", addressType),
+					resource.TestCheckResourceAttr(stateResourceName, "integration_type", "studio"),
+					resource.TestCheckResourceAttr(stateResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "flow_sid", "twilio_studio_flow.flow", "sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "retry_count", "3"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "service_sid", "data.twilio_flex_configuration.configuration", "flex_service_instance_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "account_sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "friendly_name", ""),
+					resource.TestCheckResourceAttrSet(stateResourceName, "date_created"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "date_updated"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "url"),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioFlexConversationsAddressConfigurationImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexConversationsAddressConfiguration_update(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.address_configuration", conversationsAddressConfigurationResourceName)
+	address := acceptance.TestAccData.PhoneNumber
+	addressType := "sms"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioFlexConversationsAddressConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioFlexConversationsAddressConfiguration_basic(address, addressType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexConversationsAddressConfigurationExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(stateResourceName, "retry_count", "3"),
+				),
+			},
+			{
+				Config: testAccTwilioFlexConversationsAddressConfiguration_enabled(address, addressType, false, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexConversationsAddressConfigurationExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(stateResourceName, "retry_count", "1"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "service_sid", "data.twilio_flex_configuration.configuration", "flex_service_instance_sid"),
+				),
+			},
+			{
+				Config: testAccTwilioFlexConversationsAddressConfiguration_enabled(address, addressType, false, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexConversationsAddressConfigurationExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "retry_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexConversationsAddressConfiguration_invalidStudioFlowSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioFlexConversationsAddressConfiguration_invalidStudioFlowSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of flow_sid to match regular expression "\^FW\[0-9a-fA-F\]\{32\}\$", got flow_sid`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexConversationsAddressConfiguration_invalidType(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioFlexConversationsAddressConfiguration_invalidType(),
+				ExpectError: regexp.MustCompile(`(?s)expected type to be one of \["sms" "whatsapp" "messenger" "gbm" "email" "rcs" "apple" "chat"\], got type`),
+			},
+		},
+	})
+}
+
+func testAccCheckTwilioFlexConversationsAddressConfigurationDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Conversations
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != conversationsAddressConfigurationResourceName {
+			continue
+		}
+
+		if _, err := client.Configuration().Address(rs.Primary.ID).Fetch(); err != nil {
+			if utils.IsNotFoundError(err) {
+				return nil
+			}
+			return fmt.Errorf("Error occurred when retrieving flex conversations address configuration information %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTwilioFlexConversationsAddressConfigurationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Conversations
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if _, err := client.Configuration().Address(rs.Primary.ID).Fetch(); err != nil {
+			return fmt.Errorf("Error occurred when retrieving flex conversations address configuration information %s", err.Error())
+		}
+
+		return nil
+	}
+}
+
+func testAccTwilioFlexConversationsAddressConfigurationImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("/Configuration/Addresses/%s", rs.Primary.Attributes["sid"]), nil
+	}
+}
+
+func testAccTwilioFlexConversationsAddressConfiguration_studioFlow() string {
+	return `
+data "twilio_flex_configuration" "configuration" {}
+
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "Test Flow"
+  status        = "published"
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+`
+}
+
+func testAccTwilioFlexConversationsAddressConfiguration_basic(address string, addressType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "twilio_flex_conversations_address_configuration" "address_configuration" {
+  address  = "%s"
+  type     = "%s"
+  flow_sid = twilio_studio_flow.flow.sid
+}
+`, testAccTwilioFlexConversationsAddressConfiguration_studioFlow(), address, addressType)
+}
+
+func testAccTwilioFlexConversationsAddressConfiguration_enabled(address string, addressType string, enabled bool, retryCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "twilio_flex_conversations_address_configuration" "address_configuration" {
+  address     = "%s"
+  type        = "%s"
+  enabled     = %t
+  flow_sid    = twilio_studio_flow.flow.sid
+  retry_count = %d
+}
+`, testAccTwilioFlexConversationsAddressConfiguration_studioFlow(), address, addressType, enabled, retryCount)
+}
+
+func testAccTwilioFlexConversationsAddressConfiguration_invalidStudioFlowSid() string {
+	return `
+resource "twilio_flex_conversations_address_configuration" "address_configuration" {
+  address  = "+4471234567890"
+  type     = "sms"
+  flow_sid = "flow_sid"
+}
+`
+}
+
+func testAccTwilioFlexConversationsAddressConfiguration_invalidType() string {
+	return `
+resource "twilio_flex_conversations_address_configuration" "address_configuration" {
+  address  = "+4471234567890"
+  type     = "type"
+  flow_sid = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+}
+`
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var conversationsWebChatResourceName = "twilio_flex_conversations_web_chat"
+
+func TestAccTwilioFlexConversationsWebChat_basic(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.web_chat", conversationsWebChatResourceName)
+	address := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioFlexConversationsWebChatDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioFlexConversationsWebChat_basic(address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexConversationsWebChatExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "address", address),
+					resource.TestCheckResourceAttr(stateResourceName, "type", "chat"),
+					resource.TestCheckResourceAttr(stateResourceName, "integration_type", "studio"),
+					resource.TestCheckResourceAttr(stateResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "flow_sid", "twilio_studio_flow.flow", "sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "retry_count", "3"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "service_sid", "data.twilio_flex_configuration.configuration", "flex_service_instance_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "account_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "date_created"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "date_updated"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "url"),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioFlexConversationsWebChatImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTwilioFlexConversationsWebChatDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Conversations
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != conversationsWebChatResourceName {
+			continue
+		}
+
+		if _, err := client.Configuration().Address(rs.Primary.ID).Fetch(); err != nil {
+			if utils.IsNotFoundError(err) {
+				return nil
+			}
+			return fmt.Errorf("Error occurred when retrieving flex conversations web chat information %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTwilioFlexConversationsWebChatExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Conversations
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if _, err := client.Configuration().Address(rs.Primary.ID).Fetch(); err != nil {
+			return fmt.Errorf("Error occurred when retrieving flex conversations web chat information %s", err.Error())
+		}
+
+		return nil
+	}
+}
+
+func testAccTwilioFlexConversationsWebChatImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("/Configuration/Addresses/%s", rs.Primary.Attributes["sid"]), nil
+	}
+}
+
+func testAccTwilioFlexConversationsWebChat_basic(address string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "twilio_flex_conversations_web_chat" "web_chat" {
+  address  = "%s"
+  flow_sid = twilio_studio_flow.flow.sid
+}
+`, testAccTwilioFlexConversationsAddressConfiguration_studioFlow(), address)
+}
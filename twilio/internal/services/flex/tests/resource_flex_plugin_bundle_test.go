@@ -0,0 +1,232 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var pluginBundleResourceName = "twilio_flex_plugin_bundle"
+
+func TestAccTwilioFlexPluginBundle_basic(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.plugin_bundle", pluginBundleResourceName)
+
+	uniqueName := "plugin-" + acctest.RandString(10)
+	source := writePluginBundleFiles(t, map[string]string{
+		"plugin-sample.js": "console.log('v1')",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioFlexPluginBundleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioFlexPluginBundle_basic(uniqueName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexPluginBundleExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "unique_name", uniqueName),
+					resource.TestCheckResourceAttr(stateResourceName, "service_unique_name", uniqueName),
+					resource.TestCheckResourceAttr(stateResourceName, "source", source),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "1"),
+					resource.TestMatchResourceAttr(stateResourceName, "source_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttrSet(stateResourceName, "account_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "service_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "environment_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "domain_name"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "build_sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "deployment_sid"),
+					resource.TestMatchResourceAttr(stateResourceName, "plugin_url", regexp.MustCompile(fmt.Sprintf(`^https://.+\.twil\.io/plugins/%s/[0-9a-f]{16}/plugin-sample\.js$`, uniqueName))),
+				),
+			},
+			{
+				Config:   testAccTwilioFlexPluginBundle_basic(uniqueName, source),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexPluginBundle_update(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.plugin_bundle", pluginBundleResourceName)
+
+	uniqueName := "plugin-" + acctest.RandString(10)
+	source := writePluginBundleFiles(t, map[string]string{
+		"plugin-sample.js": "console.log('v1')",
+	})
+
+	var firstPluginURL string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioFlexPluginBundleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioFlexPluginBundle_basic(uniqueName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexPluginBundleExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "1"),
+					testAccCheckTwilioFlexPluginBundlePluginURL(stateResourceName, &firstPluginURL),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filepath.Join(source, "plugin-sample.js"), []byte("console.log('v2')"), 0644); err != nil {
+						t.Fatalf("err: %s", err.Error())
+					}
+				},
+				Config: testAccTwilioFlexPluginBundle_basic(uniqueName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexPluginBundleExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "2"),
+					testAccCheckTwilioFlexPluginBundlePluginURLChanged(stateResourceName, &firstPluginURL),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexPluginBundle_plugin(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.plugin", pluginResourceName)
+	bundleStateResourceName := fmt.Sprintf("%s.plugin_bundle", pluginBundleResourceName)
+
+	uniqueName := "plugin-" + acctest.RandString(10)
+	source := writePluginBundleFiles(t, map[string]string{
+		"plugin-sample.js":     "console.log('v1')",
+		"plugin-sample.js.map": "{}",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioFlexPluginBundleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioFlexPluginBundle_plugin(uniqueName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexPluginBundleExists(bundleStateResourceName),
+					testAccCheckTwilioFlexPluginExists(stateResourceName),
+					resource.TestCheckResourceAttrPair(stateResourceName, "plugin_url", bundleStateResourceName, "plugin_url"),
+					resource.TestCheckResourceAttr(stateResourceName, "version", "1.0.1"),
+					resource.TestCheckResourceAttr(stateResourceName, "private", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexPluginBundle_invalidEntryFile(t *testing.T) {
+	source := writePluginBundleFiles(t, map[string]string{
+		"plugin-sample.js": "console.log('plugin')",
+		"vendor.js":        "console.log('vendor')",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioFlexPluginBundle_basic("plugin-"+acctest.RandString(10), source),
+				ExpectError: regexp.MustCompile(`(?s)Expected 1 JavaScript file in the root of the source directory but found 2`),
+			},
+		},
+	})
+}
+
+func testAccCheckTwilioFlexPluginBundleDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Serverless
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != pluginBundleResourceName {
+			continue
+		}
+
+		if _, err := client.Service(rs.Primary.ID).Fetch(); err != nil {
+			if utils.IsNotFoundError(err) {
+				return nil
+			}
+			return fmt.Errorf("Error occurred when retrieving plugin bundle information %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTwilioFlexPluginBundleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Serverless
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if _, err := client.Service(rs.Primary.ID).Environment(rs.Primary.Attributes["environment_sid"]).Fetch(); err != nil {
+			return fmt.Errorf("Error occurred when retrieving plugin bundle information %s", err.Error())
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTwilioFlexPluginBundlePluginURL(name string, pluginURL *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*pluginURL = rs.Primary.Attributes["plugin_url"]
+		return nil
+	}
+}
+
+func testAccCheckTwilioFlexPluginBundlePluginURLChanged(name string, previousPluginURL *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.Attributes["plugin_url"] == *previousPluginURL {
+			return fmt.Errorf("Expected the plugin url to change when the bundle content changed but it remained %s", *previousPluginURL)
+		}
+		return nil
+	}
+}
+
+func testAccTwilioFlexPluginBundle_basic(uniqueName string, source string) string {
+	return fmt.Sprintf(`
+resource "twilio_flex_plugin_bundle" "plugin_bundle" {
+  unique_name = "%s"
+  source      = "%s"
+}
+`, uniqueName, source)
+}
+
+func testAccTwilioFlexPluginBundle_plugin(uniqueName string, source string) string {
+	return fmt.Sprintf(`
+resource "twilio_flex_plugin_bundle" "plugin_bundle" {
+  unique_name = "%[1]s"
+  source      = "%[2]s"
+}
+
+resource "twilio_flex_plugin" "plugin" {
+  unique_name = "%[1]s"
+  version     = "1.0.${twilio_flex_plugin_bundle.plugin_bundle.revision}"
+  plugin_url  = twilio_flex_plugin_bundle.plugin_bundle.plugin_url
+  private     = true
+}
+`, uniqueName, source)
+}
@@ -41,6 +41,47 @@ func TestAccTwilioFlexPluginRelease_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateIdFunc: testAccTwilioFlexPluginReleaseImportStateIdFunc(stateResourceName),
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"previous_release_sid",
+					"previous_configuration_sid",
+				},
+			},
+		},
+	})
+}
+
+func TestAccTwilioFlexPluginRelease_restorePreviousRelease(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.plugin_release", pluginReleaseResourceName)
+	previousStateResourceName := fmt.Sprintf("%s.previous", pluginReleaseResourceName)
+	previousPluginConfigurationStateResourceName := "twilio_flex_plugin_configuration.previous"
+
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioFlexPluginReleaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioFlexPluginRelease_previousRelease(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexPluginReleaseExists(previousStateResourceName),
+				),
+			},
+			{
+				Config: testAccTwilioFlexPluginRelease_restorePreviousRelease(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexPluginReleaseExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "restore_previous_release", "true"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "previous_release_sid", previousStateResourceName, "sid"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "previous_configuration_sid", previousPluginConfigurationStateResourceName, "sid"),
+				),
+			},
+			{
+				Config: testAccTwilioFlexPluginRelease_previousRelease(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioFlexActiveReleaseConfiguration(previousPluginConfigurationStateResourceName),
+				),
 			},
 		},
 	})
@@ -133,6 +174,32 @@ func testAccCheckTwilioFlexPluginReleaseExists(name string) resource.TestCheckFu
 	}
 }
 
+func testAccCheckTwilioFlexActiveReleaseConfiguration(configurationName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Flex
+
+		rs, ok := s.RootModule().Resources[configurationName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", configurationName)
+		}
+
+		paginator := client.PluginReleases.NewReleasesPaginator()
+		paginator.Next()
+
+		if paginator.Error() != nil {
+			return fmt.Errorf("Error occurred when retrieving plugin releases %s", paginator.Error().Error())
+		}
+		if len(paginator.Releases) == 0 {
+			return fmt.Errorf("No active plugin release was found")
+		}
+		if paginator.Releases[0].ConfigurationSid != rs.Primary.ID {
+			return fmt.Errorf("Expected the active release to use configuration (%s) but got (%s)", rs.Primary.ID, paginator.Releases[0].ConfigurationSid)
+		}
+
+		return nil
+	}
+}
+
 func testAccTwilioFlexPluginReleaseImportStateIdFunc(name string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[name]
@@ -185,6 +252,41 @@ resource "twilio_flex_plugin_release" "plugin_release" {
 `, name, url)
 }
 
+func testAccTwilioFlexPluginRelease_previousRelease(name string) string {
+	return fmt.Sprintf(`
+resource "twilio_flex_plugin_configuration" "previous" {
+  name = "%s-previous"
+}
+
+resource "twilio_flex_plugin_release" "previous" {
+  configuration_sid = twilio_flex_plugin_configuration.previous.sid
+}
+`, name)
+}
+
+func testAccTwilioFlexPluginRelease_restorePreviousRelease(name string) string {
+	return fmt.Sprintf(`
+resource "twilio_flex_plugin_configuration" "previous" {
+  name = "%[1]s-previous"
+}
+
+resource "twilio_flex_plugin_release" "previous" {
+  configuration_sid = twilio_flex_plugin_configuration.previous.sid
+}
+
+resource "twilio_flex_plugin_configuration" "plugin_configuration" {
+  name = "%[1]s"
+}
+
+resource "twilio_flex_plugin_release" "plugin_release" {
+  configuration_sid        = twilio_flex_plugin_configuration.plugin_configuration.sid
+  restore_previous_release = true
+
+  depends_on = [twilio_flex_plugin_release.previous]
+}
+`, name)
+}
+
 func testAccTwilioFlexPluginRelease_invalidConfigurationSid() string {
 	return `
 resource "twilio_flex_plugin_release" "plugin_release" {
@@ -0,0 +1,96 @@
+package phone_number
+
+import (
+	"context"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOutgoingCallerIDs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOutgoingCallerIDsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
+			"outgoing_caller_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"phone_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOutgoingCallerIDsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	accountSid := d.Get("account_sid").(string)
+	paginator := client.Account(accountSid).OutgoingCallerIDs.NewOutgoingCallerIDsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+
+	err := paginator.Error
+	if err != nil {
+		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
+		return diag.Errorf("Failed to list outgoing caller ids: %s", err.Error())
+	}
+
+	d.SetId(accountSid)
+	d.Set("account_sid", accountSid)
+
+	outgoingCallerIDs := make([]interface{}, 0)
+
+	for _, outgoingCallerID := range paginator.OutgoingCallerIDs {
+		outgoingCallerIDMap := make(map[string]interface{})
+
+		outgoingCallerIDMap["sid"] = outgoingCallerID.Sid
+		outgoingCallerIDMap["friendly_name"] = outgoingCallerID.FriendlyName
+		outgoingCallerIDMap["phone_number"] = outgoingCallerID.PhoneNumber
+		outgoingCallerIDMap["date_created"] = outgoingCallerID.DateCreated.Time.Format(time.RFC3339)
+
+		if outgoingCallerID.DateUpdated != nil {
+			outgoingCallerIDMap["date_updated"] = outgoingCallerID.DateUpdated.Format(time.RFC3339)
+		}
+
+		outgoingCallerIDs = append(outgoingCallerIDs, outgoingCallerIDMap)
+	}
+
+	d.Set("outgoing_caller_ids", &outgoingCallerIDs)
+
+	return nil
+}
@@ -0,0 +1,292 @@
+package phone_number
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v2/regulations"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/service/api/v2010/account/available_phone_number/local"
+	"github.com/RJPearson94/twilio-sdk-go/service/api/v2010/account/available_phone_number/mobile"
+	"github.com/RJPearson94/twilio-sdk-go/service/api/v2010/account/available_phone_number/toll_free"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourcePhoneNumberRegulations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePhoneNumberRegulationsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
+			"iso_country": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"number_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"local",
+					"mobile",
+					"national",
+					"toll-free",
+				}, false),
+			},
+			"end_user_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"individual",
+					"business",
+				}, false),
+			},
+			"address_requirements": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"regulations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_user_requirements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"requirement_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"fields": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"supporting_document_requirements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"requirement_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"accepted_documents": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"fields": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePhoneNumberRegulationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Numbers
+
+	accountSid := d.Get("account_sid").(string)
+	countryCode := d.Get("iso_country").(string)
+	numberType := d.Get("number_type").(string)
+	endUserType := d.Get("end_user_type").(string)
+
+	paginator := client.Regulations.NewRegulationsPaginatorWithOptions(&regulations.RegulationsPageOptions{
+		EndUserType: sdkUtils.String(endUserType),
+		IsoCountry:  sdkUtils.String(countryCode),
+		NumberType:  sdkUtils.String(numberType),
+	})
+	for paginator.NextWithContext(ctx) {
+	}
+
+	err := paginator.Error
+	if err != nil {
+		return diag.Errorf("Failed to list regulations: %s", err.Error())
+	}
+
+	addressRequirements, diagErr := lookupAddressRequirements(ctx, meta, accountSid, countryCode, numberType)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", accountSid, countryCode, numberType, endUserType))
+	d.Set("account_sid", accountSid)
+	d.Set("iso_country", countryCode)
+	d.Set("number_type", numberType)
+	d.Set("end_user_type", endUserType)
+	d.Set("address_requirements", addressRequirements)
+
+	regulationList := make([]interface{}, 0)
+
+	for _, regulation := range paginator.Regulations {
+		endUserRequirements := make([]interface{}, 0)
+		for _, endUserRequirement := range regulation.Requirements.EndUser {
+			endUserRequirements = append(endUserRequirements, map[string]interface{}{
+				"name":             endUserRequirement.Name,
+				"type":             endUserRequirement.Type,
+				"requirement_name": endUserRequirement.RequirementName,
+				"fields":           endUserRequirement.Fields,
+			})
+		}
+
+		supportingDocumentRequirements := make([]interface{}, 0)
+		for _, supportingDocuments := range regulation.Requirements.SupportingDocument {
+			for _, supportingDocumentRequirement := range supportingDocuments {
+				acceptedDocuments := make([]interface{}, 0)
+				for _, acceptedDocument := range supportingDocumentRequirement.AcceptedDocuments {
+					acceptedDocuments = append(acceptedDocuments, map[string]interface{}{
+						"name":   acceptedDocument.Name,
+						"type":   acceptedDocument.Type,
+						"fields": acceptedDocument.Fields,
+					})
+				}
+
+				supportingDocumentRequirements = append(supportingDocumentRequirements, map[string]interface{}{
+					"name":               supportingDocumentRequirement.Name,
+					"type":               supportingDocumentRequirement.Type,
+					"requirement_name":   supportingDocumentRequirement.RequirementName,
+					"description":        supportingDocumentRequirement.Description,
+					"accepted_documents": acceptedDocuments,
+				})
+			}
+		}
+
+		regulationList = append(regulationList, map[string]interface{}{
+			"sid":                              regulation.Sid,
+			"friendly_name":                    regulation.FriendlyName,
+			"end_user_requirements":            endUserRequirements,
+			"supporting_document_requirements": supportingDocumentRequirements,
+			"url":                              regulation.URL,
+		})
+	}
+
+	d.Set("regulations", &regulationList)
+
+	return nil
+}
+
+// lookupAddressRequirements returns the address requirements of the first available phone number for the country and number type.
+// The regulations API does not return the address scope, so the available phone numbers API is used instead.
+// An empty string is returned when the number type cannot be searched or no phone numbers are available
+func lookupAddressRequirements(ctx context.Context, meta interface{}, accountSid string, countryCode string, numberType string) (string, diag.Diagnostics) {
+	client := meta.(*common.TwilioClient).API
+	availablePhoneNumber := client.Account(accountSid).AvailablePhoneNumber(countryCode)
+
+	addressRequirements := make([]string, 0)
+	var err error
+
+	switch numberType {
+	case "local":
+		pageResponse, pageErr := availablePhoneNumber.Local.PageWithContext(ctx, &local.AvailablePhoneNumbersPageOptions{PageSize: sdkUtils.Int(1)})
+		if pageErr == nil {
+			for _, phoneNumber := range pageResponse.AvailablePhoneNumbers {
+				addressRequirements = append(addressRequirements, phoneNumber.AddressRequirements)
+			}
+		}
+		err = pageErr
+	case "mobile":
+		pageResponse, pageErr := availablePhoneNumber.Mobile.PageWithContext(ctx, &mobile.AvailablePhoneNumbersPageOptions{PageSize: sdkUtils.Int(1)})
+		if pageErr == nil {
+			for _, phoneNumber := range pageResponse.AvailablePhoneNumbers {
+				addressRequirements = append(addressRequirements, phoneNumber.AddressRequirements)
+			}
+		}
+		err = pageErr
+	case "toll-free":
+		pageResponse, pageErr := availablePhoneNumber.TollFree.PageWithContext(ctx, &toll_free.AvailablePhoneNumbersPageOptions{PageSize: sdkUtils.Int(1)})
+		if pageErr == nil {
+			for _, phoneNumber := range pageResponse.AvailablePhoneNumbers {
+				addressRequirements = append(addressRequirements, phoneNumber.AddressRequirements)
+			}
+		}
+		err = pageErr
+	default:
+		return "", nil
+	}
+
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return "", nil
+		}
+		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
+		return "", diag.Errorf("Failed to list available %s phone numbers: %s", numberType, err.Error())
+	}
+
+	if len(addressRequirements) == 0 {
+		return "", nil
+	}
+	return addressRequirements[0], nil
+}
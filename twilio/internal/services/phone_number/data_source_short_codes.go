@@ -0,0 +1,121 @@
+package phone_number
+
+import (
+	"context"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceShortCodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceShortCodesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
+			"short_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"short_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sms_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sms_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sms_fallback_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sms_fallback_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceShortCodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	accountSid := d.Get("account_sid").(string)
+	paginator := client.Account(accountSid).ShortCodes.NewShortCodesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+
+	err := paginator.Error
+	if err != nil {
+		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
+		return diag.Errorf("Failed to list short codes: %s", err.Error())
+	}
+
+	d.SetId(accountSid)
+	d.Set("account_sid", accountSid)
+
+	shortCodes := make([]interface{}, 0)
+
+	for _, shortCode := range paginator.ShortCodes {
+		shortCodeMap := make(map[string]interface{})
+
+		shortCodeMap["sid"] = shortCode.Sid
+		shortCodeMap["short_code"] = shortCode.ShortCode
+		shortCodeMap["friendly_name"] = shortCode.FriendlyName
+		shortCodeMap["api_version"] = shortCode.APIVersion
+		shortCodeMap["sms_url"] = shortCode.SmsURL
+		shortCodeMap["sms_method"] = shortCode.SmsMethod
+		shortCodeMap["sms_fallback_url"] = shortCode.SmsFallbackURL
+		shortCodeMap["sms_fallback_method"] = shortCode.SmsFallbackMethod
+		shortCodeMap["date_created"] = shortCode.DateCreated.Time.Format(time.RFC3339)
+
+		if shortCode.DateUpdated != nil {
+			shortCodeMap["date_updated"] = shortCode.DateUpdated.Format(time.RFC3339)
+		}
+
+		shortCodes = append(shortCodes, shortCodeMap)
+	}
+
+	d.Set("short_codes", &shortCodes)
+
+	return nil
+}
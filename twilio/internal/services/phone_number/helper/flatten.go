@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v1/port_in"
 	"github.com/RJPearson94/twilio-sdk-go/service/api/v2010/account/incoming_phone_number"
 )
 
@@ -52,3 +53,26 @@ func FlattenFax(resp *incoming_phone_number.FetchIncomingPhoneNumberResponse) *[
 		},
 	}
 }
+
+func FlattenLosingCarrierInformation(losingCarrierInformation port_in.FetchPortInLosingCarrierInformationResponse) *[]interface{} {
+	return &[]interface{}{
+		map[string]interface{}{
+			"customer_type":                   losingCarrierInformation.CustomerType,
+			"customer_name":                   losingCarrierInformation.CustomerName,
+			"account_number":                  losingCarrierInformation.AccountNumber,
+			"account_telephone_number":        losingCarrierInformation.AccountTelephoneNumber,
+			"authorized_representative":       losingCarrierInformation.AuthorizedRepresentative,
+			"authorized_representative_email": losingCarrierInformation.AuthorizedRepresentativeEmail,
+			"address": []interface{}{
+				map[string]interface{}{
+					"street":           losingCarrierInformation.Address.Street,
+					"street_secondary": losingCarrierInformation.Address.Street2,
+					"city":             losingCarrierInformation.Address.City,
+					"state":            losingCarrierInformation.Address.State,
+					"zip":              losingCarrierInformation.Address.Zip,
+					"country":          losingCarrierInformation.Address.Country,
+				},
+			},
+		},
+	}
+}
@@ -12,17 +12,25 @@ func (r Registration) Name() string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
+		"twilio_outgoing_caller_ids":                      dataSourceOutgoingCallerIDs(),
 		"twilio_phone_number":                             dataSourcePhoneNumber(),
 		"twilio_phone_number_available_local_numbers":     dataSourcePhoneNumberAvailableLocalNumbers(),
 		"twilio_phone_number_available_mobile_numbers":    dataSourcePhoneNumberAvailableMobileNumbers(),
 		"twilio_phone_number_available_toll_free_numbers": dataSourcePhoneNumberAvailableTollFreeNumbers(),
+		"twilio_phone_number_regulations":                 dataSourcePhoneNumberRegulations(),
 		"twilio_phone_numbers":                            dataSourcePhoneNumbers(),
+		"twilio_short_codes":                              dataSourceShortCodes(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"twilio_phone_number": resourcePhoneNumber(),
+		"twilio_outgoing_caller_id":                    resourceOutgoingCallerID(),
+		"twilio_outgoing_caller_id_validation_request": resourceOutgoingCallerIDValidationRequest(),
+		"twilio_phone_number":                          resourcePhoneNumber(),
+		"twilio_phone_number_hosted_sms_order":         resourcePhoneNumberHostedSmsOrder(),
+		"twilio_phone_number_port_in_request":          resourcePhoneNumberPortInRequest(),
+		"twilio_short_code":                            resourceShortCode(),
 	}
 }
@@ -0,0 +1,179 @@
+package phone_number
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/outgoing_caller_id"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/outgoing_caller_ids"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceOutgoingCallerID() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOutgoingCallerIDCreate,
+		ReadContext:   resourceOutgoingCallerIDRead,
+		UpdateContext: resourceOutgoingCallerIDUpdate,
+		DeleteContext: resourceOutgoingCallerIDDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				format := "/Accounts/(.*)/OutgoingCallerIds/(.*)"
+				regex := regexp.MustCompile(format)
+				match := regex.FindStringSubmatch(d.Id())
+
+				if len(match) != 3 {
+					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
+				}
+
+				d.Set("account_sid", match[1])
+				d.Set("sid", match[2])
+				d.SetId(match[2])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.PhoneNumberValidation(),
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOutgoingCallerIDCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	accountSid := d.Get("account_sid").(string)
+	phoneNumber := d.Get("phone_number").(string)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"verified"},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			paginator := client.Account(accountSid).OutgoingCallerIDs.NewOutgoingCallerIDsPaginatorWithOptions(&outgoing_caller_ids.OutgoingCallerIDsPageOptions{
+				PhoneNumber: sdkUtils.String(phoneNumber),
+			})
+			for paginator.NextWithContext(ctx) {
+			}
+
+			if err := paginator.Error; err != nil {
+				return nil, "", fmt.Errorf("Failed to list outgoing caller ids: %s", err.Error())
+			}
+
+			for _, outgoingCallerID := range paginator.OutgoingCallerIDs {
+				if outgoingCallerID.PhoneNumber == phoneNumber {
+					return outgoingCallerID.Sid, "verified", nil
+				}
+			}
+
+			log.Printf("[DEBUG] Outgoing caller id for phone number (%s) has not been verified yet", phoneNumber)
+			return "", "pending", nil
+		},
+	}
+
+	sid, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to wait for outgoing caller id (%s) to be verified: %s", phoneNumber, err.Error())
+	}
+
+	d.SetId(sid.(string))
+
+	if _, ok := d.GetOk("friendly_name"); ok {
+		return resourceOutgoingCallerIDUpdate(ctx, d, meta)
+	}
+	return resourceOutgoingCallerIDRead(ctx, d, meta)
+}
+
+func resourceOutgoingCallerIDRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	getResponse, err := client.Account(d.Get("account_sid").(string)).OutgoingCallerID(d.Id()).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read outgoing caller id: %s", err.Error())
+	}
+
+	d.Set("sid", getResponse.Sid)
+	d.Set("account_sid", getResponse.AccountSid)
+	d.Set("phone_number", getResponse.PhoneNumber)
+	d.Set("friendly_name", getResponse.FriendlyName)
+	d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
+
+	if getResponse.DateUpdated != nil {
+		d.Set("date_updated", getResponse.DateUpdated.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceOutgoingCallerIDUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	updateInput := &outgoing_caller_id.UpdateOutgoingCallerIDInput{
+		FriendlyName: utils.OptionalString(d, "friendly_name"),
+	}
+
+	if _, err := client.Account(d.Get("account_sid").(string)).OutgoingCallerID(d.Id()).UpdateWithContext(ctx, updateInput); err != nil {
+		return diag.Errorf("Failed to update outgoing caller id: %s", err.Error())
+	}
+
+	return resourceOutgoingCallerIDRead(ctx, d, meta)
+}
+
+func resourceOutgoingCallerIDDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	if err := client.Account(d.Get("account_sid").(string)).OutgoingCallerID(d.Id()).DeleteWithContext(ctx); err != nil {
+		return diag.Errorf("Failed to delete outgoing caller id: %s", err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,121 @@
+package phone_number
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/validation_requests"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceOutgoingCallerIDValidationRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOutgoingCallerIDValidationRequestCreate,
+		ReadContext:   resourceOutgoingCallerIDValidationRequestRead,
+		DeleteContext: resourceOutgoingCallerIDValidationRequestDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.PhoneNumberValidation(),
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"call_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 60),
+			},
+			"extension": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"status_callback_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"status_callback_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET",
+					"POST",
+				}, false),
+			},
+			"validation_code": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"call_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOutgoingCallerIDValidationRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	createInput := &validation_requests.CreateValidationRequestInput{
+		CallDelay:            utils.OptionalInt(d, "call_delay"),
+		Extension:            utils.OptionalString(d, "extension"),
+		FriendlyName:         utils.OptionalString(d, "friendly_name"),
+		PhoneNumber:          d.Get("phone_number").(string),
+		StatusCallback:       utils.OptionalString(d, "status_callback_url"),
+		StatusCallbackMethod: utils.OptionalString(d, "status_callback_method"),
+	}
+
+	createResult, err := client.Account(d.Get("account_sid").(string)).ValidationRequests.CreateWithContext(ctx, createInput)
+	if err != nil {
+		return diag.Errorf("Failed to create outgoing caller id validation request: %s", err.Error())
+	}
+
+	d.SetId(createResult.CallSid)
+	d.Set("validation_code", createResult.ValidationCode)
+	d.Set("call_sid", createResult.CallSid)
+
+	return resourceOutgoingCallerIDValidationRequestRead(ctx, d, meta)
+}
+
+func resourceOutgoingCallerIDValidationRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Validation requests cannot be retrieved via the API. So the validation request (%s) will only be read from the state", d.Id())
+
+	return nil
+}
+
+func resourceOutgoingCallerIDValidationRequestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Validation requests cannot be deleted. So the validation request (%s) will only be removed from the state", d.Id())
+
+	d.SetId("")
+	return nil
+}
@@ -3,6 +3,7 @@ package phone_number
 import (
 	"context"
 	"fmt"
+	"log"
 	"regexp"
 	"time"
 
@@ -14,8 +15,10 @@ import (
 	"github.com/RJPearson94/twilio-sdk-go/service/api/v2010/account/available_phone_number/toll_free"
 	"github.com/RJPearson94/twilio-sdk-go/service/api/v2010/account/incoming_phone_number"
 	"github.com/RJPearson94/twilio-sdk-go/service/api/v2010/account/incoming_phone_numbers"
+	"github.com/RJPearson94/twilio-sdk-go/service/monitor/v1/alerts"
 	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -279,6 +282,25 @@ func resourcePhoneNumber() *schema.Resource {
 					"Inactive",
 				}, false),
 			},
+			"wait_for_emergency_registration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"delay_in_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5000,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 			"messaging": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -528,6 +550,11 @@ func resourcePhoneNumberCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(createResult.Sid)
+
+	if err := waitForEmergencyRegistration(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	return resourcePhoneNumberRead(ctx, d, meta)
 }
 
@@ -627,6 +654,13 @@ func resourcePhoneNumberUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(updateResp.Sid)
+
+	if d.HasChanges("emergency_address_sid", "emergency_status", "wait_for_emergency_registration") {
+		if err := waitForEmergencyRegistration(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	return resourcePhoneNumberRead(ctx, d, meta)
 }
 
@@ -641,6 +675,79 @@ func resourcePhoneNumberDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// waitForEmergencyRegistration polls the phone number until the emergency address has been registered, the registration fails or the timeout is reached.
+// Polling only occurs when it has been enabled and an emergency address is associated with an active phone number
+func waitForEmergencyRegistration(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	if !d.Get("wait_for_emergency_registration.0.enabled").(bool) {
+		return nil
+	}
+
+	emergencyAddressSid, ok := d.GetOk("emergency_address_sid")
+	if !ok || d.Get("emergency_status").(string) == "Inactive" {
+		log.Printf("[INFO] No active emergency address is associated with phone number (%s). So emergency registration will not be waited for", d.Id())
+		return nil
+	}
+
+	client := meta.(*common.TwilioClient)
+	accountSid := d.Get("account_sid").(string)
+	startDate := time.Now().UTC()
+
+	stateConf := &retry.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"registered"},
+		Timeout:      timeout,
+		PollInterval: time.Duration(d.Get("wait_for_emergency_registration.0.delay_in_ms").(int)) * time.Millisecond,
+		Refresh: func() (interface{}, string, error) {
+			getResponse, err := client.APIExtensions.Account(accountSid).IncomingPhoneNumber(d.Id()).FetchEmergencyRegistrationWithContext(ctx)
+			if err != nil {
+				return nil, "", fmt.Errorf("Failed to read phone number emergency registration: %s", err.Error())
+			}
+
+			log.Printf("[DEBUG] Phone number (%s) has emergency status (%s) and emergency address status (%s)", d.Id(), getResponse.EmergencyStatus, getResponse.EmergencyAddressStatus)
+
+			switch getResponse.EmergencyAddressStatus {
+			case "registration-failure":
+				return nil, "", fmt.Errorf("Emergency address (%s) registration failed: %s", emergencyAddressSid.(string), emergencyAddressValidationMessage(ctx, client, accountSid, emergencyAddressSid.(string), d.Id(), startDate))
+			case "unregistered", "unregistration-failure":
+				return nil, "", fmt.Errorf("Emergency address (%s) registration will not complete as the emergency address status is (%s)", emergencyAddressSid.(string), getResponse.EmergencyAddressStatus)
+			}
+
+			if getResponse.EmergencyStatus == "Active" && getResponse.EmergencyAddressStatus == "registered" {
+				return getResponse, "registered", nil
+			}
+			return getResponse, "pending", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Failed to wait for emergency registration of phone number (%s): %s", d.Id(), err.Error())
+	}
+	return nil
+}
+
+// emergencyAddressValidationMessage returns the text of the latest alert raised for the emergency address or phone number since polling started.
+// If no alert can be found, the validated and verified status of the address are returned instead
+func emergencyAddressValidationMessage(ctx context.Context, client *common.TwilioClient, accountSid string, addressSid string, phoneNumberSid string, startDate time.Time) string {
+	alertsResponse, err := client.Monitor.Alerts.PageWithContext(ctx, &alerts.AlertsPageOptions{
+		StartDate: &startDate,
+	})
+	if err != nil {
+		log.Printf("[WARN] Failed to read the alerts for emergency address (%s): %s", addressSid, err.Error())
+	} else {
+		for _, alert := range alertsResponse.Alerts {
+			if (alert.ResourceSid == addressSid || alert.ResourceSid == phoneNumberSid) && alert.AlertText != nil {
+				return fmt.Sprintf("%s (error code %s)", *alert.AlertText, alert.ErrorCode)
+			}
+		}
+	}
+
+	addressResponse, err := client.API.Account(accountSid).Address(addressSid).FetchWithContext(ctx)
+	if err != nil {
+		return fmt.Sprintf("the emergency address could not be read: %s", err.Error())
+	}
+	return fmt.Sprintf("no validation message was found, the emergency address has validated set to %t and verified set to %t, please check the address details are correct", addressResponse.Validated, addressResponse.Verified)
+}
+
 func searchForPhoneNumber(ctx context.Context, d *schema.ResourceData, meta interface{}) (*string, diag.Diagnostics) {
 	typeOfPhoneNumber := d.Get("search_criteria.0.type")
 
@@ -0,0 +1,379 @@
+package phone_number
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v2/hosted_number_orders"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var hostedSmsOrderStatuses = []string{
+	"received",
+	"verified",
+	"pending-loa",
+	"carrier-processing",
+	"completed",
+	"failed",
+	"action-required",
+}
+
+func resourcePhoneNumberHostedSmsOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePhoneNumberHostedSmsOrderCreate,
+		ReadContext:   resourcePhoneNumberHostedSmsOrderRead,
+		UpdateContext: resourcePhoneNumberHostedSmsOrderUpdate,
+		DeleteContext: resourcePhoneNumberHostedSmsOrderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				format := "/HostedNumber/Orders/(.*)"
+				regex := regexp.MustCompile(format)
+				match := regex.FindStringSubmatch(d.Id())
+
+				if len(match) != 2 {
+					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
+				}
+
+				if _, errs := utils.PhoneNumberHostedNumberOrderSidValidation()(match[1], "sid"); len(errs) > 0 {
+					return nil, fmt.Errorf("The imported ID (%s) does not contain a valid hosted sms order SID: %s", d.Id(), errs[0].Error())
+				}
+
+				d.Set("sid", match[1])
+				d.SetId(match[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.PhoneNumberValidation(),
+			},
+			"address_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.AddressSidValidation(),
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"cc_emails": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"contact_title": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"contact_phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.PhoneNumberValidation(),
+			},
+			"sms_application_sid": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  utils.ApplicationSidValidation(),
+				ConflictsWith: []string{"sms_url"},
+			},
+			"sms_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"sms_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET",
+					"POST",
+				}, false),
+			},
+			"sms_fallback_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"sms_fallback_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET",
+					"POST",
+				}, false),
+			},
+			"status_callback_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"status_callback_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET",
+					"POST",
+				}, false),
+			},
+			"polling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"target_statuses": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(hostedSmsOrderStatuses, false),
+							},
+						},
+						"delay_in_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30000,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_document_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"incoming_phone_number_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePhoneNumberHostedSmsOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Numbers
+
+	createInput := &hosted_number_orders.CreateHostedNumberOrderInput{
+		AccountSid:           utils.OptionalString(d, "account_sid"),
+		AddressSid:           d.Get("address_sid").(string),
+		CcEmails:             utils.OptionalStringSlice(d, "cc_emails"),
+		ContactPhoneNumber:   d.Get("contact_phone_number").(string),
+		ContactTitle:         utils.OptionalString(d, "contact_title"),
+		Email:                d.Get("email").(string),
+		FriendlyName:         utils.OptionalString(d, "friendly_name"),
+		PhoneNumber:          d.Get("phone_number").(string),
+		SmsApplicationSid:    utils.OptionalString(d, "sms_application_sid"),
+		SmsCapability:        sdkUtils.Bool(true),
+		SmsFallbackMethod:    utils.OptionalString(d, "sms_fallback_method"),
+		SmsFallbackURL:       utils.OptionalString(d, "sms_fallback_url"),
+		SmsMethod:            utils.OptionalString(d, "sms_method"),
+		SmsURL:               utils.OptionalString(d, "sms_url"),
+		StatusCallbackMethod: utils.OptionalString(d, "status_callback_method"),
+		StatusCallbackURL:    utils.OptionalString(d, "status_callback_url"),
+	}
+
+	createResult, err := client.HostedNumberOrders.CreateWithContext(ctx, createInput)
+	if err != nil {
+		return diag.Errorf("Failed to create hosted sms order: %s", err.Error())
+	}
+
+	d.SetId(createResult.Sid)
+
+	if d.Get("polling.0.enabled").(bool) {
+		refresh := func() (string, string, error) {
+			getResponse, err := client.HostedNumberOrder(d.Id()).FetchWithContext(ctx)
+			if err != nil {
+				return "", "", fmt.Errorf("Failed to read hosted sms order: %s", err.Error())
+			}
+
+			failureReason := ""
+			if getResponse.FailureReason != nil {
+				failureReason = *getResponse.FailureReason
+			}
+			return getResponse.Status, failureReason, nil
+		}
+
+		if err := waitForOrderStatus(ctx, d, refresh, []string{"failed"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("Failed to wait for hosted sms order (%s): %s", d.Id(), err.Error())
+		}
+	}
+
+	return resourcePhoneNumberHostedSmsOrderRead(ctx, d, meta)
+}
+
+func resourcePhoneNumberHostedSmsOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Numbers
+
+	getResponse, err := client.HostedNumberOrder(d.Id()).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read hosted sms order: %s", err.Error())
+	}
+
+	d.Set("sid", getResponse.Sid)
+	d.Set("account_sid", getResponse.AccountSid)
+	d.Set("phone_number", getResponse.PhoneNumber)
+	d.Set("address_sid", getResponse.AddressSid)
+	d.Set("friendly_name", getResponse.FriendlyName)
+	d.Set("email", getResponse.Email)
+	d.Set("cc_emails", getResponse.CcEmails)
+	d.Set("contact_title", getResponse.ContactTitle)
+	d.Set("contact_phone_number", getResponse.ContactPhoneNumber)
+	d.Set("status", getResponse.Status)
+	d.Set("failure_reason", getResponse.FailureReason)
+	d.Set("signing_document_sid", getResponse.SigningDocumentSid)
+	d.Set("incoming_phone_number_sid", getResponse.IncomingPhoneNumberSid)
+	d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
+
+	if getResponse.DateUpdated != nil {
+		d.Set("date_updated", getResponse.DateUpdated.Format(time.RFC3339))
+	}
+
+	d.Set("url", getResponse.URL)
+
+	return nil
+}
+
+func resourcePhoneNumberHostedSmsOrderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Hosted sms orders cannot be updated. So only polling config can be updated without a new resource being created")
+
+	return nil
+}
+
+func resourcePhoneNumberHostedSmsOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Numbers
+
+	if d.Get("status").(string) == "completed" {
+		log.Printf("[INFO] Hosted sms order (%s) has completed so cannot be cancelled. The order will only be removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := client.HostedNumberOrder(d.Id()).DeleteWithContext(ctx); err != nil {
+		return diag.Errorf("Failed to delete hosted sms order: %s", err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// waitForOrderStatus polls the order until it reaches one of the target statuses configured in the polling block.
+// The refresh function returns the current status and any failure reason. An error is returned if the order reaches a failure status which has not been targeted
+func waitForOrderStatus(ctx context.Context, d *schema.ResourceData, refresh func() (string, string, error), failureStatuses []string, timeout time.Duration) error {
+	targetStatuses := utils.ConvertToStringSlice(d.Get("polling.0.target_statuses").([]interface{}))
+
+	stateConf := &retry.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"target"},
+		Timeout:      timeout,
+		PollInterval: time.Duration(d.Get("polling.0.delay_in_ms").(int)) * time.Millisecond,
+		Refresh: func() (interface{}, string, error) {
+			status, failureReason, err := refresh()
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("[DEBUG] Order (%s) has status (%s)", d.Id(), status)
+
+			for _, targetStatus := range targetStatuses {
+				if strings.EqualFold(status, targetStatus) {
+					return status, "target", nil
+				}
+			}
+			for _, failureStatus := range failureStatuses {
+				if strings.EqualFold(status, failureStatus) {
+					return nil, "", fmt.Errorf("The order reached status (%s) with reason (%s)", status, failureReason)
+				}
+			}
+			return status, "pending", nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -0,0 +1,519 @@
+package phone_number
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v1/port_in"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/numbers/v1/port_ins"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/phone_number/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/service/api/v2010/account/incoming_phone_numbers"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var portInRequestStatuses = []string{
+	"In review",
+	"Waiting for Signatures",
+	"In progress",
+	"Action Required",
+	"Completed",
+	"Expired",
+	"Canceled",
+}
+
+func resourcePhoneNumberPortInRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePhoneNumberPortInRequestCreate,
+		ReadContext:   resourcePhoneNumberPortInRequestRead,
+		UpdateContext: resourcePhoneNumberPortInRequestUpdate,
+		DeleteContext: resourcePhoneNumberPortInRequestDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				format := "/Porting/PortIn/(.*)"
+				regex := regexp.MustCompile(format)
+				match := regex.FindStringSubmatch(d.Id())
+
+				if len(match) != 2 {
+					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
+				}
+
+				if _, errs := utils.PhoneNumberPortInRequestSidValidation()(match[1], "sid"); len(errs) > 0 {
+					return nil, fmt.Errorf("The imported ID (%s) does not contain a valid port in request SID: %s", d.Id(), errs[0].Error())
+				}
+
+				d.Set("sid", match[1])
+				d.SetId(match[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
+			"bundle_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.BundleSidValidation(),
+			},
+			"documents": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: utils.PhoneNumberSupportingDocumentSidValidation(),
+				},
+			},
+			"notification_emails": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"target_port_in_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be in the format YYYY-MM-DD"),
+			},
+			"target_port_in_time_range_start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"target_port_in_time_range_end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"losing_carrier_information": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Business",
+								"Individual",
+							}, false),
+						},
+						"customer_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"account_number": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"account_telephone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: utils.PhoneNumberValidation(),
+						},
+						"authorized_representative": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"authorized_representative_email": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"address": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"street": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"street_secondary": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"city": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"state": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"zip": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"country": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"phone_number": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phone_number": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: utils.PhoneNumberValidation(),
+						},
+						"pin": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"polling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"target_statuses": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(portInRequestStatuses, true),
+							},
+						},
+						"delay_in_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30000,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signature_request_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port_in_phone_numbers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"phone_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rejection_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"incoming_phone_number_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePhoneNumberPortInRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).NumbersV1
+
+	phoneNumbers := make([]port_ins.CreatePortInPhoneNumberInput, 0)
+	for _, phoneNumber := range d.Get("phone_number").([]interface{}) {
+		phoneNumberMap := phoneNumber.(map[string]interface{})
+
+		phoneNumberInput := port_ins.CreatePortInPhoneNumberInput{
+			PhoneNumber: phoneNumberMap["phone_number"].(string),
+		}
+		if pin := phoneNumberMap["pin"].(string); pin != "" {
+			phoneNumberInput.Pin = sdkUtils.String(pin)
+		}
+		phoneNumbers = append(phoneNumbers, phoneNumberInput)
+	}
+
+	createInput := &port_ins.CreatePortInInput{
+		AccountSid:                 d.Get("account_sid").(string),
+		BundleSid:                  utils.OptionalString(d, "bundle_sid"),
+		Documents:                  utils.ConvertToStringSlice(d.Get("documents").([]interface{})),
+		LosingCarrierInformation:   expandLosingCarrierInformation(d),
+		NotificationEmails:         utils.OptionalStringSlice(d, "notification_emails"),
+		PhoneNumbers:               phoneNumbers,
+		TargetPortInDate:           utils.OptionalString(d, "target_port_in_date"),
+		TargetPortInTimeRangeEnd:   utils.OptionalString(d, "target_port_in_time_range_end"),
+		TargetPortInTimeRangeStart: utils.OptionalString(d, "target_port_in_time_range_start"),
+	}
+
+	createResult, err := client.PortIns.CreateWithContext(ctx, createInput)
+	if err != nil {
+		return diag.Errorf("Failed to create port in request: %s", err.Error())
+	}
+
+	d.SetId(createResult.PortInRequestSid)
+
+	if d.Get("polling.0.enabled").(bool) {
+		refresh := func() (string, string, error) {
+			getResponse, err := client.PortIn(d.Id()).FetchWithContext(ctx)
+			if err != nil {
+				return "", "", fmt.Errorf("Failed to read port in request: %s", err.Error())
+			}
+
+			rejectionReasons := make([]string, 0)
+			for _, phoneNumber := range getResponse.PhoneNumbers {
+				if phoneNumber.RejectionReason != nil {
+					rejectionReasons = append(rejectionReasons, fmt.Sprintf("%s: %s", phoneNumber.PhoneNumber, *phoneNumber.RejectionReason))
+				}
+			}
+			return getResponse.PortInRequestStatus, strings.Join(rejectionReasons, ", "), nil
+		}
+
+		if err := waitForOrderStatus(ctx, d, refresh, []string{"Expired", "Canceled"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("Failed to wait for port in request (%s): %s", d.Id(), err.Error())
+		}
+	}
+
+	return resourcePhoneNumberPortInRequestRead(ctx, d, meta)
+}
+
+func resourcePhoneNumberPortInRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).NumbersV1
+
+	getResponse, err := client.PortIn(d.Id()).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read port in request: %s", err.Error())
+	}
+
+	d.Set("sid", getResponse.PortInRequestSid)
+	d.Set("account_sid", getResponse.AccountSid)
+	d.Set("bundle_sid", getResponse.BundleSid)
+	d.Set("documents", getResponse.Documents)
+	d.Set("notification_emails", getResponse.NotificationEmails)
+	d.Set("target_port_in_date", getResponse.TargetPortInDate)
+	d.Set("target_port_in_time_range_start", getResponse.TargetPortInTimeRangeStart)
+	d.Set("target_port_in_time_range_end", getResponse.TargetPortInTimeRangeEnd)
+	d.Set("losing_carrier_information", helper.FlattenLosingCarrierInformation(getResponse.LosingCarrierInformation))
+	d.Set("phone_number", flattenPortInPhoneNumberInputs(d, getResponse.PhoneNumbers))
+
+	portInPhoneNumbers, diagErr := flattenPortInPhoneNumbers(ctx, meta, getResponse.AccountSid, getResponse.PhoneNumbers)
+	if diagErr != nil {
+		return diagErr
+	}
+	d.Set("port_in_phone_numbers", portInPhoneNumbers)
+
+	d.Set("status", getResponse.PortInRequestStatus)
+	d.Set("signature_request_url", getResponse.SignatureRequestURL)
+
+	if getResponse.DateCreated != nil {
+		d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
+	}
+
+	d.Set("url", getResponse.URL)
+
+	return nil
+}
+
+func resourcePhoneNumberPortInRequestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Port in requests cannot be updated. So only polling config can be updated without a new resource being created")
+
+	return nil
+}
+
+func resourcePhoneNumberPortInRequestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).NumbersV1
+
+	if strings.EqualFold(d.Get("status").(string), "Completed") {
+		log.Printf("[INFO] Port in request (%s) has completed so cannot be cancelled. The request will only be removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := client.PortIn(d.Id()).DeleteWithContext(ctx); err != nil {
+		return diag.Errorf("Failed to delete port in request: %s", err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandLosingCarrierInformation(d *schema.ResourceData) port_ins.CreatePortInLosingCarrierInformationInput {
+	losingCarrierInformation := port_ins.CreatePortInLosingCarrierInformationInput{
+		AuthorizedRepresentative:      d.Get("losing_carrier_information.0.authorized_representative").(string),
+		AuthorizedRepresentativeEmail: d.Get("losing_carrier_information.0.authorized_representative_email").(string),
+		CustomerName:                  d.Get("losing_carrier_information.0.customer_name").(string),
+		CustomerType:                  d.Get("losing_carrier_information.0.customer_type").(string),
+		Address: port_ins.CreatePortInLosingCarrierInformationAddressInput{
+			City:    d.Get("losing_carrier_information.0.address.0.city").(string),
+			Country: d.Get("losing_carrier_information.0.address.0.country").(string),
+			State:   d.Get("losing_carrier_information.0.address.0.state").(string),
+			Street:  d.Get("losing_carrier_information.0.address.0.street").(string),
+			Street2: utils.OptionalString(d, "losing_carrier_information.0.address.0.street_secondary"),
+			Zip:     d.Get("losing_carrier_information.0.address.0.zip").(string),
+		},
+	}
+
+	if accountNumber := d.Get("losing_carrier_information.0.account_number").(string); accountNumber != "" {
+		losingCarrierInformation.AccountNumber = sdkUtils.String(accountNumber)
+	}
+	if accountTelephoneNumber := d.Get("losing_carrier_information.0.account_telephone_number").(string); accountTelephoneNumber != "" {
+		losingCarrierInformation.AccountTelephoneNumber = sdkUtils.String(accountTelephoneNumber)
+	}
+	return losingCarrierInformation
+}
+
+// flattenPortInPhoneNumberInputs returns the requested phone numbers. The PIN is not returned by the API so the value in the state is retained
+func flattenPortInPhoneNumberInputs(d *schema.ResourceData, phoneNumbers []port_in.FetchPortInPhoneNumberResponse) []interface{} {
+	pins := make(map[string]interface{})
+	for _, phoneNumber := range d.Get("phone_number").([]interface{}) {
+		phoneNumberMap := phoneNumber.(map[string]interface{})
+		pins[phoneNumberMap["phone_number"].(string)] = phoneNumberMap["pin"]
+	}
+
+	results := make([]interface{}, 0)
+	for _, phoneNumber := range phoneNumbers {
+		results = append(results, map[string]interface{}{
+			"phone_number": phoneNumber.PhoneNumber,
+			"pin":          pins[phoneNumber.PhoneNumber],
+		})
+	}
+	return results
+}
+
+// flattenPortInPhoneNumbers returns the status of each ported phone number along with the SID of the resulting incoming phone number.
+// The port in API does not return the incoming phone number SID, so the incoming phone numbers on the account are searched once the number has been ported
+func flattenPortInPhoneNumbers(ctx context.Context, meta interface{}, accountSid string, phoneNumbers []port_in.FetchPortInPhoneNumberResponse) ([]interface{}, diag.Diagnostics) {
+	client := meta.(*common.TwilioClient).API
+
+	results := make([]interface{}, 0)
+	for _, phoneNumber := range phoneNumbers {
+		incomingPhoneNumberSid := ""
+
+		if strings.EqualFold(phoneNumber.PortInPhoneNumberStatus, "Completed") {
+			paginator := client.Account(accountSid).IncomingPhoneNumbers.NewIncomingPhoneNumbersPaginatorWithOptions(&incoming_phone_numbers.IncomingPhoneNumbersPageOptions{
+				PhoneNumber: sdkUtils.String(phoneNumber.PhoneNumber),
+			})
+			for paginator.NextWithContext(ctx) {
+			}
+
+			if err := paginator.Error(); err != nil {
+				return nil, diag.Errorf("Failed to list phone numbers: %s", err.Error())
+			}
+
+			for _, incomingPhoneNumber := range paginator.PhoneNumbers {
+				if incomingPhoneNumber.PhoneNumber == phoneNumber.PhoneNumber {
+					incomingPhoneNumberSid = incomingPhoneNumber.Sid
+					break
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"sid":                       phoneNumber.PortInPhoneNumberSid,
+			"phone_number":              phoneNumber.PhoneNumber,
+			"status":                    phoneNumber.PortInPhoneNumberStatus,
+			"rejection_reason":          phoneNumber.RejectionReason,
+			"incoming_phone_number_sid": incomingPhoneNumberSid,
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,224 @@
+package phone_number
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/short_code"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/api/v2010/account/short_codes"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceShortCode() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceShortCodeCreate,
+		ReadContext:   resourceShortCodeRead,
+		UpdateContext: resourceShortCodeUpdate,
+		DeleteContext: resourceShortCodeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				format := "/Accounts/(.*)/SMS/ShortCodes/(.*)"
+				regex := regexp.MustCompile(format)
+				match := regex.FindStringSubmatch(d.Id())
+
+				if len(match) != 3 {
+					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
+				}
+
+				if _, errs := utils.ShortCodeSidValidation()(match[2], "sid"); len(errs) > 0 {
+					return nil, fmt.Errorf("The imported ID (%s) does not contain a valid short code SID: %s", d.Id(), errs[0].Error())
+				}
+
+				d.Set("account_sid", match[1])
+				d.Set("sid", match[2])
+				d.SetId(match[2])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"sid", "short_code"},
+				ValidateFunc: utils.ShortCodeSidValidation(),
+			},
+			"account_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
+			"short_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"api_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"2010-04-01",
+					"2008-08-01",
+				}, false),
+			},
+			"sms_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"sms_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET",
+					"POST",
+				}, false),
+			},
+			"sms_fallback_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"sms_fallback_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET",
+					"POST",
+				}, false),
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceShortCodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	accountSid := d.Get("account_sid").(string)
+
+	if sid, ok := d.GetOk("sid"); ok {
+		d.SetId(sid.(string))
+	} else {
+		code := d.Get("short_code").(string)
+
+		paginator := client.Account(accountSid).ShortCodes.NewShortCodesPaginatorWithOptions(&short_codes.ShortCodesPageOptions{
+			ShortCode: sdkUtils.String(code),
+		})
+		for paginator.NextWithContext(ctx) {
+		}
+
+		if err := paginator.Error; err != nil {
+			return diag.Errorf("Failed to list short codes: %s", err.Error())
+		}
+
+		for _, shortCode := range paginator.ShortCodes {
+			if shortCode.ShortCode == code {
+				d.SetId(shortCode.Sid)
+				break
+			}
+		}
+
+		if d.Id() == "" {
+			return diag.Errorf("No short code (%s) was found on account (%s). Short codes cannot be created by Terraform, please lease the short code first", code, accountSid)
+		}
+	}
+
+	log.Printf("[INFO] Adopting short code (%s)", d.Id())
+
+	return resourceShortCodeUpdate(ctx, d, meta)
+}
+
+func resourceShortCodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	getResponse, err := client.Account(d.Get("account_sid").(string)).ShortCode(d.Id()).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read short code: %s", err.Error())
+	}
+
+	d.Set("sid", getResponse.Sid)
+	d.Set("account_sid", getResponse.AccountSid)
+	d.Set("short_code", getResponse.ShortCode)
+	d.Set("friendly_name", getResponse.FriendlyName)
+	d.Set("api_version", getResponse.APIVersion)
+	d.Set("sms_url", getResponse.SmsURL)
+	d.Set("sms_method", getResponse.SmsMethod)
+	d.Set("sms_fallback_url", getResponse.SmsFallbackURL)
+	d.Set("sms_fallback_method", getResponse.SmsFallbackMethod)
+	d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
+
+	if getResponse.DateUpdated != nil {
+		d.Set("date_updated", getResponse.DateUpdated.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceShortCodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).APIExtensions
+
+	updateInput := &short_code.UpdateShortCodeInput{
+		APIVersion:        utils.OptionalString(d, "api_version"),
+		FriendlyName:      utils.OptionalString(d, "friendly_name"),
+		SmsFallbackMethod: utils.OptionalString(d, "sms_fallback_method"),
+		SmsFallbackURL:    utils.OptionalString(d, "sms_fallback_url"),
+		SmsMethod:         utils.OptionalString(d, "sms_method"),
+		SmsURL:            utils.OptionalString(d, "sms_url"),
+	}
+
+	if _, err := client.Account(d.Get("account_sid").(string)).ShortCode(d.Id()).UpdateWithContext(ctx, updateInput); err != nil {
+		return diag.Errorf("Failed to update short code: %s", err.Error())
+	}
+
+	return resourceShortCodeRead(ctx, d, meta)
+}
+
+func resourceShortCodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Short codes cannot be released by Terraform. So the short code (%s) will only be removed from the state", d.Id())
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var outgoingCallerIDsDataSourceName = "twilio_outgoing_caller_ids"
+
+func TestAccDataSourceTwilioOutgoingCallerIDs_complete(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.outgoing_caller_ids", outgoingCallerIDsDataSourceName)
+	testData := acceptance.TestAccData
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioOutgoingCallerIDs_complete(testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "outgoing_caller_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioOutgoingCallerIDs_invalidAccountSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioOutgoingCallerIDs_invalidAccountSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of account_sid to match regular expression "\^AC\[0-9a-fA-F\]\{32\}\$", got account_sid`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioOutgoingCallerIDs_complete(testData *acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "twilio_outgoing_caller_ids" "outgoing_caller_ids" {
+  account_sid = "%s"
+}
+`, testData.AccountSid)
+}
+
+func testAccDataSourceTwilioOutgoingCallerIDs_invalidAccountSid() string {
+	return `
+data "twilio_outgoing_caller_ids" "outgoing_caller_ids" {
+  account_sid = "account_sid"
+}
+`
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var phoneNumberRegulationsDataSourceName = "twilio_phone_number_regulations"
+
+func TestAccDataSourceTwilioPhoneNumberRegulations_complete(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.regulations", phoneNumberRegulationsDataSourceName)
+	testData := acceptance.TestAccData
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioPhoneNumberRegulations_complete(testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "account_sid", testData.AccountSid),
+					resource.TestCheckResourceAttr(stateDataSourceName, "iso_country", "DE"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "number_type", "local"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "end_user_type", "business"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "address_requirements"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "regulations.0.sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "regulations.0.friendly_name"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "regulations.0.end_user_requirements.#"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "regulations.0.supporting_document_requirements.#"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "regulations.0.url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioPhoneNumberRegulations_invalidNumberType(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioPhoneNumberRegulations_invalidNumberType(),
+				ExpectError: regexp.MustCompile(`(?s)expected number_type to be one of \[local mobile national toll-free\], got shared_cost`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioPhoneNumberRegulations_complete(testData *acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "twilio_phone_number_regulations" "regulations" {
+  account_sid   = "%s"
+  iso_country   = "DE"
+  number_type   = "local"
+  end_user_type = "business"
+}
+`, testData.AccountSid)
+}
+
+func testAccDataSourceTwilioPhoneNumberRegulations_invalidNumberType() string {
+	return `
+data "twilio_phone_number_regulations" "regulations" {
+  account_sid   = "ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  iso_country   = "DE"
+  number_type   = "shared_cost"
+  end_user_type = "business"
+}
+`
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var shortCodesDataSourceName = "twilio_short_codes"
+
+func TestAccDataSourceTwilioShortCodes_complete(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.short_codes", shortCodesDataSourceName)
+	testData := acceptance.TestAccData
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioShortCodes_complete(testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "short_codes.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioShortCodes_invalidAccountSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioShortCodes_invalidAccountSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of account_sid to match regular expression "\^AC\[0-9a-fA-F\]\{32\}\$", got account_sid`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioShortCodes_complete(testData *acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "twilio_short_codes" "short_codes" {
+  account_sid = "%s"
+}
+`, testData.AccountSid)
+}
+
+func testAccDataSourceTwilioShortCodes_invalidAccountSid() string {
+	return `
+data "twilio_short_codes" "short_codes" {
+  account_sid = "account_sid"
+}
+`
+}
@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTwilioOutgoingCallerID_invalidPhoneNumber(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioOutgoingCallerID_invalidPhoneNumber(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of phone_number to match regular expression "\^\\\\\+\[1-9\]\\\\d\{1,14\}\$", got phone_number`),
+			},
+		},
+	})
+}
+
+func testAccTwilioOutgoingCallerID_invalidPhoneNumber() string {
+	return `
+resource "twilio_outgoing_caller_id" "outgoing_caller_id" {
+  account_sid  = "ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  phone_number = "phone_number"
+}
+`
+}
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTwilioOutgoingCallerIDValidationRequest_invalidPhoneNumber(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioOutgoingCallerIDValidationRequest_invalidPhoneNumber(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of phone_number to match regular expression "\^\\\\\+\[1-9\]\\\\d\{1,14\}\$", got phone_number`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioOutgoingCallerIDValidationRequest_invalidCallDelay(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioOutgoingCallerIDValidationRequest_invalidCallDelay(),
+				ExpectError: regexp.MustCompile(`(?s)expected call_delay to be in the range \(0 - 60\), got 61`),
+			},
+		},
+	})
+}
+
+func testAccTwilioOutgoingCallerIDValidationRequest_invalidPhoneNumber() string {
+	return `
+resource "twilio_outgoing_caller_id_validation_request" "validation_request" {
+  account_sid  = "ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  phone_number = "phone_number"
+}
+`
+}
+
+func testAccTwilioOutgoingCallerIDValidationRequest_invalidCallDelay() string {
+	return `
+resource "twilio_outgoing_caller_id_validation_request" "validation_request" {
+  account_sid  = "ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  phone_number = "+14155551234"
+  call_delay   = 61
+}
+`
+}
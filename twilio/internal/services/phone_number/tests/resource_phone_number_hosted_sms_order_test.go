@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTwilioPhoneNumberHostedSmsOrder_invalidAddressSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioPhoneNumberHostedSmsOrder_invalidAddressSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of address_sid to match regular expression "\^AD\[0-9a-fA-F\]\{32\}\$", got address_sid`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioPhoneNumberHostedSmsOrder_invalidTargetStatus(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioPhoneNumberHostedSmsOrder_invalidTargetStatus(),
+				ExpectError: regexp.MustCompile(`(?s)expected polling.0.target_statuses.0 to be one of \["received" "verified" "pending-loa" "carrier-processing" "completed" "failed" "action-required"\], got done`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioPhoneNumberHostedSmsOrder_missingTargetStatuses(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioPhoneNumberHostedSmsOrder_missingTargetStatuses(),
+				ExpectError: regexp.MustCompile(`(?s)The argument "target_statuses" is required, but no definition was found.`),
+			},
+		},
+	})
+}
+
+func testAccTwilioPhoneNumberHostedSmsOrder_invalidAddressSid() string {
+	return `
+resource "twilio_phone_number_hosted_sms_order" "hosted_sms_order" {
+  phone_number         = "+14155551234"
+  address_sid          = "address_sid"
+  email                = "test@example.com"
+  contact_phone_number = "+14155555678"
+}
+`
+}
+
+func testAccTwilioPhoneNumberHostedSmsOrder_invalidTargetStatus() string {
+	return `
+resource "twilio_phone_number_hosted_sms_order" "hosted_sms_order" {
+  phone_number         = "+14155551234"
+  address_sid          = "ADaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  email                = "test@example.com"
+  contact_phone_number = "+14155555678"
+
+  polling {
+    enabled         = true
+    target_statuses = ["done"]
+  }
+}
+`
+}
+
+func testAccTwilioPhoneNumberHostedSmsOrder_missingTargetStatuses() string {
+	return `
+resource "twilio_phone_number_hosted_sms_order" "hosted_sms_order" {
+  phone_number         = "+14155551234"
+  address_sid          = "ADaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  email                = "test@example.com"
+  contact_phone_number = "+14155555678"
+
+  polling {
+    enabled = true
+  }
+}
+`
+}
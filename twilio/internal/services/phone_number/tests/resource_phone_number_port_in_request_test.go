@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTwilioPhoneNumberPortInRequest_invalidDocumentSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioPhoneNumberPortInRequest_withDocumentAndDate("document_sid", "2030-01-01"),
+				ExpectError: regexp.MustCompile(`(?s)expected value of documents.0 to match regular expression "\^RD\[0-9a-fA-F\]\{32\}\$", got document_sid`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioPhoneNumberPortInRequest_invalidTargetPortInDate(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioPhoneNumberPortInRequest_withDocumentAndDate("RDaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "01/01/2030"),
+				ExpectError: regexp.MustCompile(`(?s)must be in the format YYYY-MM-DD`),
+			},
+		},
+	})
+}
+
+func testAccTwilioPhoneNumberPortInRequest_withDocumentAndDate(documentSid string, targetPortInDate string) string {
+	return `
+resource "twilio_phone_number_port_in_request" "port_in_request" {
+  account_sid         = "ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  documents           = ["` + documentSid + `"]
+  target_port_in_date = "` + targetPortInDate + `"
+
+  losing_carrier_information {
+    customer_type                   = "Business"
+    customer_name                   = "Example Inc"
+    authorized_representative       = "Jane Doe"
+    authorized_representative_email = "jane@example.com"
+
+    address {
+      street  = "1 Main Street"
+      city    = "San Francisco"
+      state   = "CA"
+      zip     = "94105"
+      country = "US"
+    }
+  }
+
+  phone_number {
+    phone_number = "+14155551234"
+  }
+}
+`
+}
@@ -128,6 +128,62 @@ func TestAccTwilioPhoneNumber_complete(t *testing.T) {
 	})
 }
 
+func TestAccTwilioPhoneNumber_waitForEmergencyRegistration(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.phone_number", phoneNumberResourceName)
+	testData := acceptance.TestAccData
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioPhoneNumber_waitForEmergencyRegistration(testData),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioPhoneNumberExists(stateResourceName),
+					resource.TestCheckResourceAttrSet(stateResourceName, "id"),
+					resource.TestCheckResourceAttr(stateResourceName, "emergency_address_sid", ""),
+					resource.TestCheckResourceAttrSet(stateResourceName, "emergency_status"),
+					resource.TestCheckResourceAttr(stateResourceName, "wait_for_emergency_registration.#", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "wait_for_emergency_registration.0.enabled", "true"),
+					resource.TestCheckResourceAttr(stateResourceName, "wait_for_emergency_registration.0.delay_in_ms", "5000"),
+				),
+			},
+			{
+				ResourceName:            stateResourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTwilioPhoneNumberImportStateIdFunc(stateResourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"search_criteria.#", "search_criteria.0", "wait_for_emergency_registration.#", "wait_for_emergency_registration.0"},
+			},
+		},
+	})
+}
+
+func TestAccTwilioPhoneNumber_waitForEmergencyRegistrationWithEmergencyAddress(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.phone_number", phoneNumberResourceName)
+	testData := acceptance.TestAccData
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioPhoneNumber_waitForEmergencyRegistrationWithEmergencyAddress(testData),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioPhoneNumberExists(stateResourceName),
+					resource.TestCheckResourceAttrSet(stateResourceName, "id"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "emergency_address_sid", "twilio_account_address.address", "sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "emergency_status", "Active"),
+					resource.TestCheckResourceAttr(stateResourceName, "wait_for_emergency_registration.#", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "wait_for_emergency_registration.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTwilioPhoneNumberDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).API
 
@@ -196,3 +252,58 @@ resource "twilio_phone_number" "phone_number" {
 }
 `, testData.AccountSid, url)
 }
+
+func testAccTwilioPhoneNumber_waitForEmergencyRegistration(testData *acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "twilio_phone_number" "phone_number" {
+  account_sid = "%s"
+
+  search_criteria {
+    type        = "mobile"
+    iso_country = "GB"
+
+    exclude_address_requirements {
+      all = true
+    }
+  }
+
+  wait_for_emergency_registration {
+    enabled = true
+  }
+}
+`, testData.AccountSid)
+}
+
+func testAccTwilioPhoneNumber_waitForEmergencyRegistrationWithEmergencyAddress(testData *acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "twilio_account_address" "address" {
+  account_sid       = "%s"
+  customer_name     = "%s"
+  street            = "%s"
+  city              = "%s"
+  region            = "%s"
+  postal_code       = "%s"
+  iso_country       = "%s"
+  emergency_enabled = true
+}
+
+resource "twilio_phone_number" "phone_number" {
+  account_sid           = twilio_account_address.address.account_sid
+  emergency_address_sid = twilio_account_address.address.sid
+  emergency_status      = "Active"
+
+  search_criteria {
+    type        = "mobile"
+    iso_country = "GB"
+
+    exclude_address_requirements {
+      all = true
+    }
+  }
+
+  wait_for_emergency_registration {
+    enabled = true
+  }
+}
+`, testData.AccountSid, testData.CustomerName, testData.Address.Street, testData.Address.City, testData.Address.Region, testData.Address.PostalCode, testData.Address.IsoCountry)
+}
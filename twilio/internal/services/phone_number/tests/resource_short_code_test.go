@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTwilioShortCode_invalidSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioShortCode_invalidSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of sid to match regular expression "\^SC\[0-9a-fA-F\]\{32\}\$", got sid`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioShortCode_noSidOrShortCode(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioShortCode_noSidOrShortCode(),
+				ExpectError: regexp.MustCompile(`(?s)one of .sid,short_code. must be specified`),
+			},
+		},
+	})
+}
+
+func testAccTwilioShortCode_invalidSid() string {
+	return `
+resource "twilio_short_code" "short_code" {
+  account_sid = "ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  sid         = "sid"
+}
+`
+}
+
+func testAccTwilioShortCode_noSidOrShortCode() string {
+	return `
+resource "twilio_short_code" "short_code" {
+  account_sid = "ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  sms_url     = "https://demo.twilio.com/welcome/sms/reply"
+}
+`
+}
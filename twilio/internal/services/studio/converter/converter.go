@@ -0,0 +1,534 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	sdkStudio "github.com/RJPearson94/twilio-sdk-go/studio"
+	"github.com/RJPearson94/twilio-sdk-go/studio/flow"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+const stateDataSource = "twilio_studio_flow_widget_state"
+
+var (
+	camelCaseRegex    = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+	invalidLabelRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+	contentTypeRegex  = regexp.MustCompile(`^(application/x-www-form-urlencoded|application/json);charset=(.+)$`)
+)
+
+type convertedState struct {
+	state      flow.State
+	dataSource string
+	label      string
+}
+
+type converter struct {
+	states    []*convertedState
+	stateMap  map[string]*convertedState
+	backEdges map[string]map[string]bool
+}
+
+// Convert generates HCL for a Studio Flow definition JSON using the twilio_studio_flow_widget_* data sources and the twilio_studio_flow_definition data source.
+// Transitions between states are expressed as references to the data source of the next state, except where the reference would introduce a cycle.
+// Any state which cannot be represented by a typed widget data source is converted to a twilio_studio_flow_widget_state data source
+func Convert(definition string, definitionName string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(definition))
+	decoder.UseNumber()
+
+	var flowDefinition sdkStudio.Flow
+	if err := decoder.Decode(&flowDefinition); err != nil {
+		return "", fmt.Errorf("Failed to parse flow definition: %s", err.Error())
+	}
+
+	if len(flowDefinition.States) == 0 {
+		return "", fmt.Errorf("The flow definition does not contain any states")
+	}
+
+	c := &converter{
+		states:    []*convertedState{},
+		stateMap:  make(map[string]*convertedState),
+		backEdges: make(map[string]map[string]bool),
+	}
+
+	labels := make(map[string]bool)
+	for _, state := range flowDefinition.States {
+		if _, ok := c.stateMap[state.Name]; ok {
+			return "", fmt.Errorf("The flow definition contains more than one state with the name (%s)", state.Name)
+		}
+
+		dataSource := stateDataSource
+		if widget, ok := widgetSchemas[state.Type]; ok && isSupported(widget, state) {
+			dataSource = widget.dataSource
+		}
+
+		convertedState := &convertedState{
+			state:      state,
+			dataSource: dataSource,
+			label:      uniqueLabel(labels, dataSource, state.Name),
+		}
+		c.states = append(c.states, convertedState)
+		c.stateMap[state.Name] = convertedState
+	}
+
+	c.findBackEdges(flowDefinition.InitialState)
+
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	for _, state := range c.states {
+		if state.dataSource == stateDataSource {
+			if err := c.appendState(body, state); err != nil {
+				return "", err
+			}
+		} else {
+			c.appendWidget(body, state)
+		}
+		body.AppendNewline()
+	}
+
+	c.appendDefinition(body, flowDefinition, definitionName)
+
+	return string(hclwrite.Format(bytes.TrimSpace(file.Bytes()))) + "\n", nil
+}
+
+// findBackEdges uses depth first search to find the transitions which create a cycle in the flow. These transitions are output as the state name instead of a reference to prevent Terraform reporting a dependency cycle
+func (c *converter) findBackEdges(initialState string) {
+	visited := make(map[string]bool)
+	onStack := make(map[string]bool)
+
+	var visit func(string)
+	visit = func(name string) {
+		visited[name] = true
+		onStack[name] = true
+
+		for _, transition := range c.stateMap[name].state.Transitions {
+			if transition.Next == nil {
+				continue
+			}
+			next := *transition.Next
+			if _, ok := c.stateMap[next]; !ok {
+				continue
+			}
+
+			if onStack[next] {
+				if c.backEdges[name] == nil {
+					c.backEdges[name] = make(map[string]bool)
+				}
+				c.backEdges[name][next] = true
+				continue
+			}
+			if !visited[next] {
+				visit(next)
+			}
+		}
+
+		onStack[name] = false
+	}
+
+	if _, ok := c.stateMap[initialState]; ok {
+		visit(initialState)
+	}
+	for _, state := range c.states {
+		if !visited[state.state.Name] {
+			visit(state.state.Name)
+		}
+	}
+}
+
+func (c *converter) reference(from string, to string, attribute string) hclwrite.Tokens {
+	state, ok := c.stateMap[to]
+	if !ok || c.backEdges[from][to] {
+		return hclwrite.TokensForValue(cty.StringVal(to))
+	}
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "data"},
+		hcl.TraverseAttr{Name: state.dataSource},
+		hcl.TraverseAttr{Name: state.label},
+		hcl.TraverseAttr{Name: attribute},
+	})
+}
+
+func (c *converter) appendWidget(body *hclwrite.Body, state *convertedState) {
+	widget := widgetSchemas[state.state.Type]
+	properties := propertiesMap(state.state)
+
+	block := body.AppendNewBlock("data", []string{state.dataSource, state.label})
+	blockBody := block.Body()
+	blockBody.SetAttributeValue("name", cty.StringVal(state.state.Name))
+
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		if key != "offset" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return widget.properties[keys[i]].attribute < widget.properties[keys[j]].attribute
+	})
+
+	keyValueProperties := []string{}
+	for _, key := range keys {
+		property := widget.properties[key]
+		value := properties[key]
+
+		switch property.propertyType {
+		case stringProperty:
+			blockBody.SetAttributeValue(property.attribute, cty.StringVal(value.(string)))
+		case intProperty:
+			number, _ := value.(json.Number).Int64()
+			blockBody.SetAttributeValue(property.attribute, cty.NumberIntVal(number))
+		case boolProperty:
+			blockBody.SetAttributeValue(property.attribute, cty.BoolVal(value.(bool)))
+		case stringListProperty:
+			blockBody.SetAttributeValue(property.attribute, stringList(value.([]interface{})))
+		case separatedStringProperty:
+			blockBody.SetAttributeValue(property.attribute, stringList(strings.Split(value.(string), property.separator)))
+		case jsonProperty:
+			jsonValue, _ := jsonToValue(value.(string))
+			blockBody.SetAttributeRaw(property.attribute, hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(jsonValue)))
+		case contentTypeProperty:
+			match := contentTypeRegex.FindStringSubmatch(value.(string))
+			blockBody.SetAttributeValue("content_type", cty.StringVal(match[1]))
+			blockBody.SetAttributeValue("charset", cty.StringVal(match[2]))
+		case keyValueProperty:
+			keyValueProperties = append(keyValueProperties, key)
+		}
+	}
+
+	for _, key := range keyValueProperties {
+		for _, item := range properties[key].([]interface{}) {
+			itemMap := item.(map[string]interface{})
+
+			blockBody.AppendNewline()
+			keyValueBody := blockBody.AppendNewBlock(widget.properties[key].attribute, nil).Body()
+			keyValueBody.SetAttributeValue("key", cty.StringVal(itemMap["key"].(string)))
+			keyValueBody.SetAttributeValue("value", cty.StringVal(itemMap["value"].(string)))
+		}
+	}
+
+	if hasNextTransition(state.state) {
+		blockBody.AppendNewline()
+		transitionsBody := blockBody.AppendNewBlock("transitions", nil).Body()
+
+		for _, transition := range state.state.Transitions {
+			if transition.Next == nil || transition.Event == "match" {
+				continue
+			}
+			transitionsBody.SetAttributeRaw(toSnakeCase(transition.Event), c.reference(state.state.Name, *transition.Next, "name"))
+		}
+
+		for _, transition := range state.state.Transitions {
+			if transition.Next == nil || transition.Event != "match" {
+				continue
+			}
+
+			transitionsBody.AppendNewline()
+			matchesBody := transitionsBody.AppendNewBlock("matches", nil).Body()
+			matchesBody.SetAttributeRaw("next", c.reference(state.state.Name, *transition.Next, "name"))
+			appendConditions(matchesBody, transition.Conditions)
+		}
+	}
+
+	if offset, ok := properties["offset"].(map[string]interface{}); ok {
+		x, _ := offset["x"].(json.Number).Int64()
+		y, _ := offset["y"].(json.Number).Int64()
+
+		blockBody.AppendNewline()
+		offsetBody := blockBody.AppendNewBlock("offset", nil).Body()
+		offsetBody.SetAttributeValue("x", cty.NumberIntVal(x))
+		offsetBody.SetAttributeValue("y", cty.NumberIntVal(y))
+	}
+}
+
+func (c *converter) appendState(body *hclwrite.Body, state *convertedState) error {
+	properties := propertiesMap(state.state)
+
+	block := body.AppendNewBlock("data", []string{state.dataSource, state.label})
+	blockBody := block.Body()
+	blockBody.SetAttributeValue("name", cty.StringVal(state.state.Name))
+	blockBody.SetAttributeValue("type", cty.StringVal(state.state.Type))
+
+	// The properties argument only supports string values, so the properties are output as JSON when any value (including the offset) is a number, boolean, list or object
+	if isStringMap(properties) {
+		propertyValues := make(map[string]cty.Value)
+		for key, value := range properties {
+			propertyValues[key] = cty.StringVal(value.(string))
+		}
+
+		if len(propertyValues) > 0 {
+			blockBody.SetAttributeValue("properties", cty.ObjectVal(propertyValues))
+		} else {
+			blockBody.SetAttributeValue("properties", cty.EmptyObjectVal)
+		}
+	} else {
+		propertiesJSON, err := json.Marshal(properties)
+		if err != nil {
+			return fmt.Errorf("Failed to marshal the properties of %s (%s): %s", state.state.Type, state.state.Name, err.Error())
+		}
+		propertiesValue, err := jsonToValue(string(propertiesJSON))
+		if err != nil {
+			return fmt.Errorf("Failed to convert the properties of %s (%s): %s", state.state.Type, state.state.Name, err.Error())
+		}
+		blockBody.SetAttributeRaw("properties_json", hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(propertiesValue)))
+	}
+
+	for _, transition := range state.state.Transitions {
+		blockBody.AppendNewline()
+		transitionsBody := blockBody.AppendNewBlock("transitions", nil).Body()
+		transitionsBody.SetAttributeValue("event", cty.StringVal(transition.Event))
+
+		if transition.Next != nil {
+			transitionsBody.SetAttributeRaw("next", c.reference(state.state.Name, *transition.Next, "name"))
+		}
+		appendConditions(transitionsBody, transition.Conditions)
+	}
+
+	return nil
+}
+
+func (c *converter) appendDefinition(body *hclwrite.Body, flowDefinition sdkStudio.Flow, definitionName string) {
+	block := body.AppendNewBlock("data", []string{"twilio_studio_flow_definition", definitionName})
+	blockBody := block.Body()
+	blockBody.SetAttributeValue("description", cty.StringVal(flowDefinition.Description))
+
+	if state, ok := c.stateMap[flowDefinition.InitialState]; ok {
+		blockBody.SetAttributeRaw("initial_state", hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: "data"},
+			hcl.TraverseAttr{Name: state.dataSource},
+			hcl.TraverseAttr{Name: state.label},
+			hcl.TraverseAttr{Name: "name"},
+		}))
+	} else {
+		blockBody.SetAttributeValue("initial_state", cty.StringVal(flowDefinition.InitialState))
+	}
+
+	if flowDefinition.Flags != nil {
+		blockBody.AppendNewline()
+		flagsBody := blockBody.AppendNewBlock("flags", nil).Body()
+		flagsBody.SetAttributeValue("allow_concurrent_calls", cty.BoolVal(flowDefinition.Flags.AllowConcurrentCalls))
+	}
+
+	for _, state := range c.states {
+		blockBody.AppendNewline()
+		statesBody := blockBody.AppendNewBlock("states", nil).Body()
+		statesBody.SetAttributeTraversal("json", hcl.Traversal{
+			hcl.TraverseRoot{Name: "data"},
+			hcl.TraverseAttr{Name: state.dataSource},
+			hcl.TraverseAttr{Name: state.label},
+			hcl.TraverseAttr{Name: "json"},
+		})
+	}
+}
+
+func appendConditions(body *hclwrite.Body, conditions *[]flow.Condition) {
+	if conditions == nil {
+		return
+	}
+
+	for _, condition := range *conditions {
+		body.AppendNewline()
+		conditionsBody := body.AppendNewBlock("conditions", nil).Body()
+		conditionsBody.SetAttributeValue("arguments", stringList(condition.Arguments))
+		conditionsBody.SetAttributeValue("friendly_name", cty.StringVal(condition.FriendlyName))
+		conditionsBody.SetAttributeValue("type", cty.StringVal(condition.Type))
+		conditionsBody.SetAttributeValue("value", cty.StringVal(condition.Value))
+	}
+}
+
+// isSupported checks whether all of the properties and transitions of the state can be represented by the arguments of the typed widget data source
+func isSupported(widget widgetSchema, state flow.State) bool {
+	properties, ok := state.Properties.(map[string]interface{})
+	if !ok && state.Properties != nil {
+		return false
+	}
+
+	for key, value := range properties {
+		if key == "offset" {
+			if !isOffset(value) {
+				return false
+			}
+			continue
+		}
+
+		property, ok := widget.properties[key]
+		if !ok || !isSupportedValue(property, value) {
+			return false
+		}
+	}
+
+	for _, transition := range state.Transitions {
+		if !contains(widget.transitions, transition.Event) {
+			return false
+		}
+		if transition.Event == "match" {
+			if transition.Next == nil || transition.Conditions == nil || len(*transition.Conditions) == 0 {
+				return false
+			}
+		} else if transition.Conditions != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSupportedValue(property widgetProperty, value interface{}) bool {
+	switch property.propertyType {
+	case stringProperty, separatedStringProperty:
+		_, ok := value.(string)
+		return ok
+	case intProperty:
+		return isInt(value)
+	case boolProperty:
+		_, ok := value.(bool)
+		return ok
+	case stringListProperty:
+		list, ok := value.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, item := range list {
+			if _, ok := item.(string); !ok {
+				return false
+			}
+		}
+		return true
+	case jsonProperty:
+		jsonString, ok := value.(string)
+		if !ok {
+			return false
+		}
+		_, err := jsonToValue(jsonString)
+		return err == nil
+	case contentTypeProperty:
+		contentType, ok := value.(string)
+		return ok && contentTypeRegex.MatchString(contentType)
+	case keyValueProperty:
+		list, ok := value.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, item := range list {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok || len(itemMap) != 2 {
+				return false
+			}
+			if _, ok := itemMap["key"].(string); !ok {
+				return false
+			}
+			if _, ok := itemMap["value"].(string); !ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func isStringMap(values map[string]interface{}) bool {
+	for _, value := range values {
+		if _, ok := value.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func isOffset(value interface{}) bool {
+	offset, ok := value.(map[string]interface{})
+	return ok && len(offset) == 2 && isInt(offset["x"]) && isInt(offset["y"])
+}
+
+func isInt(value interface{}) bool {
+	number, ok := value.(json.Number)
+	if !ok {
+		return false
+	}
+	_, err := number.Int64()
+	return err == nil
+}
+
+func hasNextTransition(state flow.State) bool {
+	for _, transition := range state.Transitions {
+		if transition.Next != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func propertiesMap(state flow.State) map[string]interface{} {
+	if properties, ok := state.Properties.(map[string]interface{}); ok {
+		return properties
+	}
+	return map[string]interface{}{}
+}
+
+func jsonToValue(jsonString string) (cty.Value, error) {
+	jsonBytes := []byte(jsonString)
+
+	impliedType, err := ctyjson.ImpliedType(jsonBytes)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return ctyjson.Unmarshal(jsonBytes, impliedType)
+}
+
+func stringList(values interface{}) cty.Value {
+	list := []cty.Value{}
+
+	switch v := values.(type) {
+	case []string:
+		for _, value := range v {
+			list = append(list, cty.StringVal(value))
+		}
+	case []interface{}:
+		for _, value := range v {
+			list = append(list, cty.StringVal(value.(string)))
+		}
+	}
+
+	if len(list) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	return cty.TupleVal(list)
+}
+
+func uniqueLabel(labels map[string]bool, dataSource string, name string) string {
+	label := strings.Trim(invalidLabelRegex.ReplaceAllString(toSnakeCase(name), "_"), "_")
+	if label == "" {
+		label = "state"
+	} else if label[0] >= '0' && label[0] <= '9' {
+		label = "state_" + label
+	}
+
+	uniqueLabel := label
+	for i := 2; labels[dataSource+"."+uniqueLabel]; i++ {
+		uniqueLabel = fmt.Sprintf("%s_%d", label, i)
+	}
+	labels[dataSource+"."+uniqueLabel] = true
+
+	return uniqueLabel
+}
+
+func toSnakeCase(value string) string {
+	return strings.ToLower(camelCaseRegex.ReplaceAllString(value, "${1}_${2}"))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
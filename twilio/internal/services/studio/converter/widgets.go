@@ -0,0 +1,335 @@
+package converter
+
+type propertyType int
+
+const (
+	stringProperty propertyType = iota
+	intProperty
+	boolProperty
+	stringListProperty
+	jsonProperty
+	keyValueProperty
+	separatedStringProperty
+	contentTypeProperty
+)
+
+type widgetProperty struct {
+	attribute    string
+	propertyType propertyType
+	separator    string
+}
+
+type widgetSchema struct {
+	dataSource  string
+	transitions []string
+	properties  map[string]widgetProperty
+}
+
+func stringAttribute(attribute string) widgetProperty {
+	return widgetProperty{attribute: attribute, propertyType: stringProperty}
+}
+
+func intAttribute(attribute string) widgetProperty {
+	return widgetProperty{attribute: attribute, propertyType: intProperty}
+}
+
+func boolAttribute(attribute string) widgetProperty {
+	return widgetProperty{attribute: attribute, propertyType: boolProperty}
+}
+
+func stringListAttribute(attribute string) widgetProperty {
+	return widgetProperty{attribute: attribute, propertyType: stringListProperty}
+}
+
+func jsonAttribute(attribute string) widgetProperty {
+	return widgetProperty{attribute: attribute, propertyType: jsonProperty}
+}
+
+func keyValueBlock(block string) widgetProperty {
+	return widgetProperty{attribute: block, propertyType: keyValueProperty}
+}
+
+func separatedStringAttribute(attribute string, separator string) widgetProperty {
+	return widgetProperty{attribute: attribute, propertyType: separatedStringProperty, separator: separator}
+}
+
+// widgetSchemas maps the Studio widget type to the twilio_studio_flow_widget_* data source which generates the widget.
+// The properties map the JSON key of each widget property to the data source argument
+var widgetSchemas = map[string]widgetSchema{
+	"add-twiml-redirect": {
+		dataSource:  "twilio_studio_flow_widget_add_twiml_redirect",
+		transitions: []string{"fail", "return", "timeout"},
+		properties: map[string]widgetProperty{
+			"method":  stringAttribute("method"),
+			"timeout": stringAttribute("timeout"),
+			"url":     stringAttribute("url"),
+		},
+	},
+	"capture-payments": {
+		dataSource:  "twilio_studio_flow_widget_capture_payments",
+		transitions: []string{"hangup", "maxFailedAttempts", "payInterrupted", "providerError", "success", "validationError"},
+		properties: map[string]widgetProperty{
+			"currency":               stringAttribute("currency"),
+			"description":            stringAttribute("description"),
+			"language":               stringAttribute("language"),
+			"max_attempts":           intAttribute("max_attempts"),
+			"min_postal_code_length": intAttribute("min_postal_code_length"),
+			"parameters":             keyValueBlock("parameters"),
+			"payment_amount":         stringAttribute("payment_amount"),
+			"payment_connector":      stringAttribute("payment_connector"),
+			"payment_method":         stringAttribute("payment_method"),
+			"payment_token_type":     stringAttribute("payment_token_type"),
+			"postal_code":            stringAttribute("postal_code"),
+			"security_code":          boolAttribute("security_code"),
+			"timeout":                intAttribute("timeout"),
+			"valid_card_types":       stringListAttribute("valid_card_types"),
+		},
+	},
+	"connect-ai-assistant": {
+		dataSource:  "twilio_studio_flow_widget_connect_ai_assistant",
+		transitions: []string{"completed", "failed"},
+		properties: map[string]widgetProperty{
+			"assistant_sid": stringAttribute("assistant_sid"),
+			"identity":      stringAttribute("identity"),
+		},
+	},
+	"connect-call-to": {
+		dataSource:  "twilio_studio_flow_widget_connect_call_to",
+		transitions: []string{"callCompleted", "hangup"},
+		properties: map[string]widgetProperty{
+			"caller_id":    stringAttribute("caller_id"),
+			"noun":         stringAttribute("noun"),
+			"record":       boolAttribute("record"),
+			"sip_endpoint": stringAttribute("sip_endpoint"),
+			"sip_password": stringAttribute("sip_password"),
+			"sip_username": stringAttribute("sip_username"),
+			"timeout":      intAttribute("timeout"),
+			"to":           stringAttribute("to"),
+		},
+	},
+	"connect-other": {
+		dataSource:  "twilio_studio_flow_widget_connect_other",
+		transitions: []string{"callCompleted", "hangup"},
+		properties: map[string]widgetProperty{
+			"caller_id": stringAttribute("caller_id"),
+			"record":    boolAttribute("record"),
+			"timeout":   intAttribute("timeout"),
+			"to":        stringAttribute("to"),
+		},
+	},
+	"connect-virtual-agent": {
+		dataSource:  "twilio_studio_flow_widget_connect_virtual_agent",
+		transitions: []string{"hangup", "return"},
+		properties: map[string]widgetProperty{
+			"connector":          stringAttribute("connector"),
+			"language":           stringAttribute("language"),
+			"sentiment_analysis": stringAttribute("sentiment_analysis"),
+			"status_callback":    stringAttribute("status_callback_url"),
+		},
+	},
+	"enqueue-call": {
+		dataSource:  "twilio_studio_flow_widget_enqueue_call",
+		transitions: []string{"callComplete", "callFailure", "failedToEnqueue"},
+		properties: map[string]widgetProperty{
+			"priority":        intAttribute("priority"),
+			"queue_name":      stringAttribute("queue_name"),
+			"task_attributes": stringAttribute("task_attributes"),
+			"timeout":         intAttribute("timeout"),
+			"wait_url":        stringAttribute("wait_url"),
+			"wait_url_method": stringAttribute("wait_url_method"),
+			"workflow_sid":    stringAttribute("workflow_sid"),
+		},
+	},
+	"fork-stream": {
+		dataSource:  "twilio_studio_flow_widget_fork_stream",
+		transitions: []string{"next"},
+		properties: map[string]widgetProperty{
+			"stream_action":         stringAttribute("stream_action"),
+			"stream_connector":      stringAttribute("stream_connector"),
+			"stream_name":           stringAttribute("stream_name"),
+			"stream_parameters":     keyValueBlock("stream_parameters"),
+			"stream_track":          stringAttribute("stream_track"),
+			"stream_transport_type": stringAttribute("stream_transport_type"),
+			"stream_url":            stringAttribute("stream_url"),
+		},
+	},
+	"gather-input-on-call": {
+		dataSource:  "twilio_studio_flow_widget_gather_input_on_call",
+		transitions: []string{"keypress", "speech", "timeout"},
+		properties: map[string]widgetProperty{
+			"finish_on_key":    stringAttribute("finish_on_key"),
+			"gather_language":  stringAttribute("gather_language"),
+			"hints":            separatedStringAttribute("hints", ","),
+			"language":         stringAttribute("language"),
+			"loop":             intAttribute("loop"),
+			"number_of_digits": intAttribute("number_of_digits"),
+			"play":             stringAttribute("play"),
+			"profanity_filter": stringAttribute("profanity_filter"),
+			"say":              stringAttribute("say"),
+			"speech_model":     stringAttribute("speech_model"),
+			"speech_timeout":   stringAttribute("speech_timeout"),
+			"stop_gather":      boolAttribute("stop_gather"),
+			"timeout":          intAttribute("timeout"),
+			"voice":            stringAttribute("voice"),
+		},
+	},
+	"make-http-request": {
+		dataSource:  "twilio_studio_flow_widget_make_http_request",
+		transitions: []string{"failed", "success"},
+		properties: map[string]widgetProperty{
+			"body":         stringAttribute("body"),
+			"content_type": {attribute: "content_type", propertyType: contentTypeProperty},
+			"method":       stringAttribute("method"),
+			"parameters":   keyValueBlock("parameters"),
+			"url":          stringAttribute("url"),
+		},
+	},
+	"make-outgoing-call-v2": {
+		dataSource:  "twilio_studio_flow_widget_make_outgoing_call",
+		transitions: []string{"answered", "busy", "failed", "noAnswer"},
+		properties: map[string]widgetProperty{
+			"detect_answering_machine":               boolAttribute("detect_answering_machine"),
+			"from":                                   stringAttribute("from"),
+			"machine_detection":                      stringAttribute("machine_detection"),
+			"machine_detection_silence_timeout":      stringAttribute("machine_detection_silence_timeout"),
+			"machine_detection_speech_end_threshold": stringAttribute("machine_detection_speech_end_threshold"),
+			"machine_detection_speech_threshold":     stringAttribute("machine_detection_speech_threshold"),
+			"machine_detection_timeout":              stringAttribute("machine_detection_timeout"),
+			"record":                                 boolAttribute("record"),
+			"recording_channels":                     stringAttribute("recording_channels"),
+			"recording_status_callback":              stringAttribute("recording_status_callback_url"),
+			"send_digits":                            stringAttribute("send_digits"),
+			"sip_auth_password":                      stringAttribute("sip_auth_password"),
+			"sip_auth_username":                      stringAttribute("sip_auth_username"),
+			"timeout":                                intAttribute("timeout"),
+			"to":                                     stringAttribute("to"),
+			"trim":                                   stringAttribute("trim"),
+		},
+	},
+	"record-call": {
+		dataSource:  "twilio_studio_flow_widget_record_call",
+		transitions: []string{"failed", "success"},
+		properties: map[string]widgetProperty{
+			"record_call":                      boolAttribute("record_call"),
+			"recording_channels":               stringAttribute("recording_channels"),
+			"recording_status_callback":        stringAttribute("recording_status_callback_url"),
+			"recording_status_callback_events": separatedStringAttribute("recording_status_callback_events", " "),
+			"recording_status_callback_method": stringAttribute("recording_status_callback_method"),
+			"trim":                             stringAttribute("trim"),
+		},
+	},
+	"record-voicemail": {
+		dataSource:  "twilio_studio_flow_widget_record_voicemail",
+		transitions: []string{"hangup", "noAudio", "recordingComplete"},
+		properties: map[string]widgetProperty{
+			"finish_on_key":                 stringAttribute("finish_on_key"),
+			"max_length":                    intAttribute("max_length"),
+			"play_beep":                     stringAttribute("play_beep"),
+			"recording_status_callback_url": stringAttribute("recording_status_callback_url"),
+			"timeout":                       intAttribute("timeout"),
+			"transcribe":                    boolAttribute("transcribe"),
+			"transcription_callback_url":    stringAttribute("transcription_callback_url"),
+			"trim":                          stringAttribute("trim"),
+		},
+	},
+	"run-function": {
+		dataSource:  "twilio_studio_flow_widget_run_function",
+		transitions: []string{"fail", "success"},
+		properties: map[string]widgetProperty{
+			"environment_sid": stringAttribute("environment_sid"),
+			"function_sid":    stringAttribute("function_sid"),
+			"parameters":      keyValueBlock("parameters"),
+			"service_sid":     stringAttribute("service_sid"),
+			"url":             stringAttribute("url"),
+		},
+	},
+	"run-subflow": {
+		dataSource:  "twilio_studio_flow_widget_run_subflow",
+		transitions: []string{"completed", "failed"},
+		properties: map[string]widgetProperty{
+			"flow_revision": stringAttribute("flow_revision"),
+			"flow_sid":      stringAttribute("flow_sid"),
+			"parameters":    keyValueBlock("parameters"),
+		},
+	},
+	"say-play": {
+		dataSource:  "twilio_studio_flow_widget_say_play",
+		transitions: []string{"audioComplete"},
+		properties: map[string]widgetProperty{
+			"digits":   stringAttribute("digits"),
+			"language": stringAttribute("language"),
+			"loop":     intAttribute("loop"),
+			"play":     stringAttribute("play"),
+			"say":      stringAttribute("say"),
+			"voice":    stringAttribute("voice"),
+		},
+	},
+	"send-and-wait-for-reply": {
+		dataSource:  "twilio_studio_flow_widget_send_and_wait_for_reply",
+		transitions: []string{"deliveryFailure", "incomingMessage", "timeout"},
+		properties: map[string]widgetProperty{
+			"attributes": jsonAttribute("attributes"),
+			"body":       stringAttribute("body"),
+			"channel":    stringAttribute("channel_sid"),
+			"from":       stringAttribute("from"),
+			"media_url":  stringAttribute("media_url"),
+			"service":    stringAttribute("service_sid"),
+			"timeout":    stringAttribute("timeout"),
+		},
+	},
+	"send-email": {
+		dataSource:  "twilio_studio_flow_widget_send_email",
+		transitions: []string{"failed", "sent"},
+		properties: map[string]widgetProperty{
+			"body":    stringAttribute("body"),
+			"from":    stringAttribute("from"),
+			"subject": stringAttribute("subject"),
+			"to":      stringAttribute("to"),
+		},
+	},
+	"send-message": {
+		dataSource:  "twilio_studio_flow_widget_send_message",
+		transitions: []string{"failed", "sent"},
+		properties: map[string]widgetProperty{
+			"attributes": jsonAttribute("attributes"),
+			"body":       stringAttribute("body"),
+			"channel":    stringAttribute("channel_sid"),
+			"from":       stringAttribute("from"),
+			"media_url":  stringAttribute("media_url"),
+			"service":    stringAttribute("service_sid"),
+			"to":         stringAttribute("to"),
+		},
+	},
+	"send-to-flex": {
+		dataSource:  "twilio_studio_flow_widget_send_to_flex",
+		transitions: []string{"callComplete", "callFailure", "failedToEnqueue"},
+		properties: map[string]widgetProperty{
+			"attributes":    jsonAttribute("attributes"),
+			"channel":       stringAttribute("channel_sid"),
+			"priority":      stringAttribute("priority"),
+			"timeout":       stringAttribute("timeout"),
+			"waitUrl":       stringAttribute("wait_url"),
+			"waitUrlMethod": stringAttribute("wait_url_method"),
+			"workflow":      stringAttribute("workflow_sid"),
+		},
+	},
+	"set-variables": {
+		dataSource:  "twilio_studio_flow_widget_set_variables",
+		transitions: []string{"next"},
+		properties: map[string]widgetProperty{
+			"variables": keyValueBlock("variables"),
+		},
+	},
+	"split-based-on": {
+		dataSource:  "twilio_studio_flow_widget_split_based_on",
+		transitions: []string{"noMatch", "match"},
+		properties: map[string]widgetProperty{
+			"input": stringAttribute("input"),
+		},
+	},
+	"trigger": {
+		dataSource:  "twilio_studio_flow_widget_trigger",
+		transitions: []string{"incomingCall", "incomingMessage", "incomingParent", "incomingRequest"},
+		properties:  map[string]widgetProperty{},
+	},
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/studio/helper"
 	sdkStudio "github.com/RJPearson94/twilio-sdk-go/studio"
 	"github.com/RJPearson94/twilio-sdk-go/studio/flow"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -39,6 +40,31 @@ func dataSourceStudioFlowDefinition() *schema.Resource {
 					},
 				},
 			},
+			"auto_layout": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"horizontal_spacing": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      450,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"vertical_spacing": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      250,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 			"initial_state": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -82,6 +108,21 @@ func dataSourceStudioFlowDefinitionRead(ctx context.Context, d *schema.ResourceD
 		states = append(states, state)
 	}
 
+	if d.Get("auto_layout.0.enabled").(bool) {
+		offsets := helper.AutoLayout(d.Get("initial_state").(string), states, d.Get("auto_layout.0.horizontal_spacing").(int), d.Get("auto_layout.0.vertical_spacing").(int))
+
+		for _, state := range states {
+			stateProperties, ok := state.Properties.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// Offsets which have been explicitly set on the widget are retained
+			if _, ok := stateProperties["offset"]; !ok {
+				stateProperties["offset"] = offsets[state.Name]
+			}
+		}
+	}
+
 	flow := sdkStudio.Flow{
 		Description:  d.Get("description").(string),
 		Flags:        flags,
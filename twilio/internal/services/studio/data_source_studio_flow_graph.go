@@ -0,0 +1,48 @@
+package studio
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/studio/helper"
+	sdkStudio "github.com/RJPearson94/twilio-sdk-go/studio"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceStudioFlowGraph() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStudioFlowGraphRead,
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"mermaid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dot": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceStudioFlowGraphRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var definition sdkStudio.Flow
+	if err := json.Unmarshal([]byte(d.Get("definition").(string)), &definition); err != nil {
+		return diag.Errorf("Failed to unmarshal json to flow definition struct %s", err.Error())
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("mermaid", helper.FlowGraphMermaid(definition))
+	d.Set("dot", helper.FlowGraphDOT(definition))
+
+	return nil
+}
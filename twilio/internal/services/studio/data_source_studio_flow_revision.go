@@ -0,0 +1,115 @@
+package studio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceStudioFlowRevision() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStudioFlowRevisionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"flow_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.StudioFlowSidValidation(),
+			},
+			"revision": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"webhook_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceStudioFlowRevisionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Studio
+
+	flowSid := d.Get("flow_sid").(string)
+	revision := d.Get("revision").(int)
+	getResponse, err := client.Flow(flowSid).Revision(revision).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return diag.Errorf("Revision (%d) of studio flow with sid (%s) was not found", revision, flowSid)
+		}
+		return diag.Errorf("Failed to read studio flow revision: %s", err.Error())
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", getResponse.Sid, getResponse.Revision))
+	d.Set("flow_sid", getResponse.Sid)
+	d.Set("revision", getResponse.Revision)
+	d.Set("account_sid", getResponse.AccountSid)
+	d.Set("friendly_name", getResponse.FriendlyName)
+
+	json, err := structure.FlattenJsonToString(getResponse.Definition)
+	if err != nil {
+		return diag.Errorf("Unable to flatten definition json to string")
+	}
+	d.Set("definition", json)
+	d.Set("status", getResponse.Status)
+	d.Set("commit_message", getResponse.CommitMessage)
+	d.Set("valid", getResponse.Valid)
+	d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
+
+	if getResponse.DateUpdated != nil {
+		d.Set("date_updated", getResponse.DateUpdated.Format(time.RFC3339))
+	}
+	d.Set("url", getResponse.URL)
+	d.Set("webhook_url", getResponse.WebhookURL)
+
+	return nil
+}
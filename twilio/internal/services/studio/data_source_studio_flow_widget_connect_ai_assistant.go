@@ -0,0 +1,122 @@
+package studio
+
+import (
+	"context"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/studio/widgets"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/studio/properties"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceStudioFlowWidgetConnectAIAssistant() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStudioFlowWidgetConnectAIAssistantRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transitions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"completed": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"failed": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"offset": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"x": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"y": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"assistant_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"identity": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceStudioFlowWidgetConnectAIAssistantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	nextTransitions := widgets.ConnectAIAssistantNextTransitions{}
+	if _, ok := d.GetOk("transitions"); ok {
+		nextTransitions.Completed = utils.OptionalString(d, "transitions.0.completed")
+		nextTransitions.Failed = utils.OptionalString(d, "transitions.0.failed")
+	}
+
+	var offset *properties.Offset
+	if _, ok := d.GetOk("offset"); ok {
+		offset = &properties.Offset{
+			X: d.Get("offset.0.x").(int),
+			Y: d.Get("offset.0.y").(int),
+		}
+	}
+
+	widget := &widgets.ConnectAIAssistant{
+		Name:            name,
+		NextTransitions: nextTransitions,
+		Properties: widgets.ConnectAIAssistantProperties{
+			AssistantSid: d.Get("assistant_sid").(string),
+			Identity:     utils.OptionalString(d, "identity"),
+			Offset:       offset,
+		},
+	}
+
+	if err := widget.Validate(); err != nil {
+		return diag.Errorf("Connect AI assistant widget failed validation: %s", err.Error())
+	}
+
+	state, err := widget.ToState()
+	if err != nil {
+		return diag.Errorf("Failed to create connect AI assistant widget: %s", err.Error())
+	}
+
+	json, jsonErr := state.ToString()
+	if jsonErr != nil {
+		return diag.Errorf("Failed to marshal connect AI assistant widget to JSON: %s", jsonErr.Error())
+	}
+
+	d.SetId(name)
+	d.Set("json", json)
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package studio
+
+import (
+	"context"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/studio/widgets"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/studio/properties"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceStudioFlowWidgetConnectOther() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStudioFlowWidgetConnectOtherRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transitions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"call_completed": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"hangup": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"offset": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"x": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"y": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"caller_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"record": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func dataSourceStudioFlowWidgetConnectOtherRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	nextTransitions := widgets.ConnectOtherNextTransitions{}
+	if _, ok := d.GetOk("transitions"); ok {
+		nextTransitions.CallCompleted = utils.OptionalString(d, "transitions.0.call_completed")
+		nextTransitions.Hangup = utils.OptionalString(d, "transitions.0.hangup")
+	}
+
+	var offset *properties.Offset
+	if _, ok := d.GetOk("offset"); ok {
+		offset = &properties.Offset{
+			X: d.Get("offset.0.x").(int),
+			Y: d.Get("offset.0.y").(int),
+		}
+	}
+
+	widget := &widgets.ConnectOther{
+		Name:            name,
+		NextTransitions: nextTransitions,
+		Properties: widgets.ConnectOtherProperties{
+			CallerID: utils.OptionalString(d, "caller_id"),
+			Offset:   offset,
+			Record:   utils.OptionalBool(d, "record"),
+			Timeout:  utils.OptionalInt(d, "timeout"),
+			To:       d.Get("to").(string),
+		},
+	}
+
+	if err := widget.Validate(); err != nil {
+		return diag.Errorf("Connect other widget failed validation: %s", err.Error())
+	}
+
+	state, err := widget.ToState()
+	if err != nil {
+		return diag.Errorf("Failed to create connect other widget: %s", err.Error())
+	}
+
+	json, jsonErr := state.ToString()
+	if jsonErr != nil {
+		return diag.Errorf("Failed to marshal connect other widget to JSON: %s", jsonErr.Error())
+	}
+
+	d.SetId(name)
+	d.Set("json", json)
+
+	return nil
+}
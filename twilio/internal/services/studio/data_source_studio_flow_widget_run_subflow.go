@@ -0,0 +1,162 @@
+package studio
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/studio/properties"
+	"github.com/RJPearson94/twilio-sdk-go/studio/widgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceStudioFlowWidgetRunSubflow() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStudioFlowWidgetRunSubflowRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transitions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"completed": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"failed": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"offset": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"x": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"y": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"flow_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.StudioFlowSidValidation(),
+			},
+			"flow_revision": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "LatestPublished",
+				ValidateFunc: validation.Any(
+					validation.StringInSlice([]string{
+						"LatestPublished",
+					}, false),
+					validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), ""),
+				),
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStudioFlowWidgetRunSubflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	nextTransitions := widgets.RunSubflowNextTransitions{}
+	if _, ok := d.GetOk("transitions"); ok {
+		nextTransitions.Completed = utils.OptionalString(d, "transitions.0.completed")
+		nextTransitions.Failed = utils.OptionalString(d, "transitions.0.failed")
+	}
+
+	var offset *properties.Offset
+	if _, ok := d.GetOk("offset"); ok {
+		offset = &properties.Offset{
+			X: d.Get("offset.0.x").(int),
+			Y: d.Get("offset.0.y").(int),
+		}
+	}
+
+	var subflowParameters *[]widgets.RunSubflowParameter
+	if v, ok := d.GetOk("parameters"); ok {
+		parameters := []widgets.RunSubflowParameter{}
+		for _, parameter := range v.([]interface{}) {
+			parameterMap := parameter.(map[string]interface{})
+			parameters = append(parameters, widgets.RunSubflowParameter{
+				Key:   parameterMap["key"].(string),
+				Value: parameterMap["value"].(string),
+			})
+		}
+		subflowParameters = &parameters
+	}
+
+	widget := &widgets.RunSubflow{
+		Name:            name,
+		NextTransitions: nextTransitions,
+		Properties: widgets.RunSubflowProperties{
+			FlowRevision: d.Get("flow_revision").(string),
+			FlowSid:      d.Get("flow_sid").(string),
+			Offset:       offset,
+			Parameters:   subflowParameters,
+		},
+	}
+
+	if err := widget.Validate(); err != nil {
+		return diag.Errorf("Run subflow widget failed validation: %s", err.Error())
+	}
+
+	state, err := widget.ToState()
+	if err != nil {
+		return diag.Errorf("Failed to create run subflow widget: %s", err.Error())
+	}
+
+	json, jsonErr := state.ToString()
+	if jsonErr != nil {
+		return diag.Errorf("Failed to marshal run subflow widget to JSON: %s", jsonErr.Error())
+	}
+
+	d.SetId(name)
+	d.Set("json", json)
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package studio
+
+import (
+	"context"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/sdk/studio/widgets"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/studio/properties"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceStudioFlowWidgetSendEmail() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStudioFlowWidgetSendEmailRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transitions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failed": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sent": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"offset": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"x": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"y": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func dataSourceStudioFlowWidgetSendEmailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	nextTransitions := widgets.SendEmailNextTransitions{}
+	if _, ok := d.GetOk("transitions"); ok {
+		nextTransitions.Failed = utils.OptionalString(d, "transitions.0.failed")
+		nextTransitions.Sent = utils.OptionalString(d, "transitions.0.sent")
+	}
+
+	var offset *properties.Offset
+	if _, ok := d.GetOk("offset"); ok {
+		offset = &properties.Offset{
+			X: d.Get("offset.0.x").(int),
+			Y: d.Get("offset.0.y").(int),
+		}
+	}
+
+	widget := &widgets.SendEmail{
+		Name:            name,
+		NextTransitions: nextTransitions,
+		Properties: widgets.SendEmailProperties{
+			Body:    d.Get("body").(string),
+			From:    d.Get("from").(string),
+			Offset:  offset,
+			Subject: utils.OptionalString(d, "subject"),
+			To:      d.Get("to").(string),
+		},
+	}
+
+	if err := widget.Validate(); err != nil {
+		return diag.Errorf("Send email widget failed validation: %s", err.Error())
+	}
+
+	state, err := widget.ToState()
+	if err != nil {
+		return diag.Errorf("Failed to create send email widget: %s", err.Error())
+	}
+
+	json, jsonErr := state.ToString()
+	if jsonErr != nil {
+		return diag.Errorf("Failed to marshal send email widget to JSON: %s", jsonErr.Error())
+	}
+
+	d.SetId(name)
+	d.Set("json", json)
+
+	return nil
+}
@@ -2,6 +2,8 @@ package studio
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
 	"github.com/RJPearson94/twilio-sdk-go/studio/flow"
@@ -77,8 +79,14 @@ func dataSourceStudioFlowWidgetState() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"properties": {
-				Type:     schema.TypeMap,
-				Required: true,
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ExactlyOneOf: []string{"properties", "properties_json"},
+			},
+			"properties_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
 			},
 		},
 	}
@@ -119,9 +127,21 @@ func dataSourceStudioFlowWidgetStateRead(ctx context.Context, d *schema.Resource
 		transitions = append(transitions, transition)
 	}
 
+	properties := d.Get("properties").(map[string]interface{})
+	if propertiesJSON, ok := d.GetOk("properties_json"); ok {
+		// Numbers are decoded as json.Number so the values are output exactly as they were supplied
+		decoder := json.NewDecoder(strings.NewReader(propertiesJSON.(string)))
+		decoder.UseNumber()
+
+		properties = map[string]interface{}{}
+		if err := decoder.Decode(&properties); err != nil {
+			return diag.Errorf("Failed to unmarshal properties json: %s", err.Error())
+		}
+	}
+
 	state := flow.State{
 		Name:        name,
-		Properties:  d.Get("properties").(map[string]interface{}),
+		Properties:  properties,
 		Transitions: transitions,
 		Type:        d.Get("type").(string),
 	}
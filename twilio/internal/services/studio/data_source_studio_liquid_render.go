@@ -0,0 +1,70 @@
+package studio
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/studio/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var liquidContextVariables = []string{"contact", "flow", "trigger", "widgets"}
+
+func dataSourceStudioLiquidRender() *schema.Resource {
+	dataSourceSchema := map[string]*schema.Schema{
+		"output": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"template": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"strict_variables": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+
+	for _, variable := range liquidContextVariables {
+		dataSourceSchema[variable] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceStudioLiquidRenderRead,
+
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceStudioLiquidRenderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bindings := map[string]interface{}{}
+	for _, variable := range liquidContextVariables {
+		if v, ok := d.GetOk(variable); ok {
+			var value interface{}
+			if err := json.Unmarshal([]byte(v.(string)), &value); err != nil {
+				return diag.Errorf("Failed to unmarshal %s json: %s", variable, err.Error())
+			}
+			bindings[variable] = value
+		}
+	}
+
+	output, err := helper.RenderLiquidTemplate(d.Get("template").(string), bindings, d.Get("strict_variables").(bool))
+	if err != nil {
+		return diag.Errorf("Failed to render liquid template: %s", err.Error())
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("output", output)
+
+	return nil
+}
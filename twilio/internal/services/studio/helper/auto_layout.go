@@ -0,0 +1,128 @@
+package helper
+
+import (
+	"sort"
+
+	"github.com/RJPearson94/twilio-sdk-go/studio/flow"
+	"github.com/RJPearson94/twilio-sdk-go/studio/properties"
+)
+
+// AutoLayout calculates the canvas offset of each state using a layered layout of the transition graph.
+// States are placed in rows (layers) based on the longest path from the initial state, ignoring any transitions which loop back to an earlier state.
+// The states in each row are ordered by the average position of the states which transition to them and are centred horizontally
+func AutoLayout(initialState string, states []flow.State, horizontalSpacing int, verticalSpacing int) map[string]properties.Offset {
+	offsets := make(map[string]properties.Offset)
+	if len(states) == 0 {
+		return offsets
+	}
+
+	indexes := make(map[string]int)
+	for index, state := range states {
+		indexes[state.Name] = index
+	}
+
+	successors := make([][]int, len(states))
+	for index, state := range states {
+		seen := make(map[int]bool)
+		for _, transition := range state.Transitions {
+			if transition.Next == nil {
+				continue
+			}
+			if next, ok := indexes[*transition.Next]; ok && !seen[next] {
+				seen[next] = true
+				successors[index] = append(successors[index], next)
+			}
+		}
+	}
+
+	// Depth first search is used to find the transitions which create cycles, the remaining transitions form a directed acyclic graph
+	visited := make([]bool, len(states))
+	onStack := make([]bool, len(states))
+	discoveryOrder := make([]int, len(states))
+	postOrder := make([]int, 0, len(states))
+	predecessors := make([][]int, len(states))
+	discovered := 0
+
+	var visit func(int)
+	visit = func(index int) {
+		visited[index] = true
+		onStack[index] = true
+		discoveryOrder[index] = discovered
+		discovered++
+
+		for _, next := range successors[index] {
+			if onStack[next] {
+				continue
+			}
+			predecessors[next] = append(predecessors[next], index)
+			if !visited[next] {
+				visit(next)
+			}
+		}
+
+		onStack[index] = false
+		postOrder = append(postOrder, index)
+	}
+
+	if initialIndex, ok := indexes[initialState]; ok {
+		visit(initialIndex)
+	}
+	for index := range states {
+		if !visited[index] {
+			visit(index)
+		}
+	}
+
+	// Reverse post order is a topological order of the directed acyclic graph, so each state is placed one row below the lowest state which transitions to it
+	layers := make([]int, len(states))
+	maxLayer := 0
+	for i := len(postOrder) - 1; i >= 0; i-- {
+		index := postOrder[i]
+		for _, predecessor := range predecessors[index] {
+			if layers[predecessor]+1 > layers[index] {
+				layers[index] = layers[predecessor] + 1
+			}
+		}
+		if layers[index] > maxLayer {
+			maxLayer = layers[index]
+		}
+	}
+
+	rows := make([][]int, maxLayer+1)
+	for index := range states {
+		rows[layers[index]] = append(rows[layers[index]], index)
+	}
+
+	xPositions := make([]int, len(states))
+	for layer, row := range rows {
+		barycenters := make(map[int]float64)
+		for _, index := range row {
+			if len(predecessors[index]) == 0 {
+				barycenters[index] = 0
+				continue
+			}
+			total := 0
+			for _, predecessor := range predecessors[index] {
+				total += xPositions[predecessor]
+			}
+			barycenters[index] = float64(total) / float64(len(predecessors[index]))
+		}
+
+		sort.SliceStable(row, func(i, j int) bool {
+			if barycenters[row[i]] != barycenters[row[j]] {
+				return barycenters[row[i]] < barycenters[row[j]]
+			}
+			return discoveryOrder[row[i]] < discoveryOrder[row[j]]
+		})
+
+		for position, index := range row {
+			xPositions[index] = position*horizontalSpacing - ((len(row)-1)*horizontalSpacing)/2
+			offsets[states[index].Name] = properties.Offset{
+				X: xPositions[index],
+				Y: layer * verticalSpacing,
+			}
+		}
+	}
+
+	return offsets
+}
@@ -0,0 +1,86 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	sdkStudio "github.com/RJPearson94/twilio-sdk-go/studio"
+	"github.com/RJPearson94/twilio-sdk-go/studio/flow"
+)
+
+// newFlowGraph converts the Studio Flow definition into a graph. Each state is a node identified by the state name and labelled with the state name and widget type,
+// the initial state is drawn as a stadium and each transition is an edge labelled with the event name. State names are not valid Mermaid identifiers, so each state is given a Mermaid ID
+func newFlowGraph(definition sdkStudio.Flow) utils.Graph {
+	graph := utils.Graph{
+		Direction: "TD",
+		Nodes:     []utils.GraphNode{},
+		Edges:     []utils.GraphEdge{},
+	}
+
+	nodes := make(map[string]bool)
+	node := func(name string, stateType string) string {
+		if _, ok := nodes[name]; ok {
+			return name
+		}
+
+		newNode := utils.GraphNode{
+			ID:        name,
+			MermaidID: fmt.Sprintf("state%d", len(graph.Nodes)),
+			Label:     name,
+			Shape:     utils.GraphShapeBox,
+		}
+		if stateType != "" {
+			newNode.Label = fmt.Sprintf("%s\n%s", name, stateType)
+		}
+		if name == definition.InitialState {
+			newNode.Shape = utils.GraphShapeStadium
+		}
+
+		nodes[name] = true
+		graph.Nodes = append(graph.Nodes, newNode)
+		return name
+	}
+
+	for _, state := range definition.States {
+		node(state.Name, state.Type)
+	}
+
+	for _, state := range definition.States {
+		for _, transition := range state.Transitions {
+			if transition.Next == nil || *transition.Next == "" {
+				continue
+			}
+			graph.Edges = append(graph.Edges, utils.GraphEdge{
+				From:  state.Name,
+				To:    node(*transition.Next, ""),
+				Label: transitionLabel(transition),
+			})
+		}
+	}
+
+	return graph
+}
+
+// transitionLabel returns the event name of the transition. The friendly name of each condition is included for split based on transitions
+func transitionLabel(transition flow.Transition) string {
+	if transition.Conditions == nil || len(*transition.Conditions) == 0 {
+		return transition.Event
+	}
+
+	friendlyNames := []string{}
+	for _, condition := range *transition.Conditions {
+		friendlyNames = append(friendlyNames, condition.FriendlyName)
+	}
+	return fmt.Sprintf("%s: %s", transition.Event, strings.Join(friendlyNames, ", "))
+}
+
+// FlowGraphMermaid generates a Mermaid flowchart of the Studio Flow definition
+func FlowGraphMermaid(definition sdkStudio.Flow) string {
+	return newFlowGraph(definition).Mermaid()
+}
+
+// FlowGraphDOT generates a Graphviz DOT digraph of the Studio Flow definition
+func FlowGraphDOT(definition sdkStudio.Flow) string {
+	return newFlowGraph(definition).DOT()
+}
@@ -0,0 +1,88 @@
+package helper
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/osteele/liquid"
+)
+
+var liquidErrorPrefix = regexp.MustCompile(`^Liquid error(?: \(line \d+\))?: `)
+
+// LiquidError is a parse or render error for a Liquid template, including the location of the tag or object which caused the error
+type LiquidError struct {
+	Message string
+	Line    int
+	Column  int
+}
+
+func (e *LiquidError) Error() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%s (line %d, column %d)", e.Message, e.Line, e.Column)
+}
+
+// RenderLiquidTemplate parses the Liquid template and renders it using the bindings as the template context.
+// When strictVariables is true, referencing a variable which is not present in the bindings results in an error
+func RenderLiquidTemplate(template string, bindings map[string]interface{}, strictVariables bool) (string, error) {
+	engine := liquid.NewEngine()
+	if strictVariables {
+		engine.StrictVariables()
+	}
+
+	// The engine counts lines from the supplied starting line, so 1 is used to report line numbers which match the template
+	parsedTemplate, err := engine.ParseTemplateLocation([]byte(template), "", 1)
+	if err != nil {
+		return "", newLiquidError(template, err.LineNumber(), err.Error())
+	}
+
+	output, err := parsedTemplate.RenderString(bindings)
+	if err != nil {
+		return "", newLiquidError(template, err.LineNumber(), err.Error())
+	}
+	return output, nil
+}
+
+// newLiquidError converts the error returned by the Liquid engine into a LiquidError.
+// The engine only reports the line number and appends the source of the tag or object to the message, so the column is calculated by finding the source on the reported line
+func newLiquidError(template string, line int, message string) *LiquidError {
+	message = liquidErrorPrefix.ReplaceAllString(message, "")
+	if line <= 0 {
+		return &LiquidError{Message: message}
+	}
+
+	lineStart := 0
+	for i := 1; i < line; i++ {
+		index := strings.Index(template[lineStart:], "\n")
+		if index == -1 {
+			return &LiquidError{Message: message, Line: line}
+		}
+		lineStart += index + 1
+	}
+	lineLength := strings.Index(template[lineStart:], "\n")
+	if lineLength == -1 {
+		lineLength = len(template) - lineStart
+	}
+
+	for index := strings.Index(message, " in "); index != -1; {
+		source := message[index+len(" in "):]
+		if sourceIndex := strings.Index(template[lineStart:], source); source != "" && sourceIndex != -1 && sourceIndex <= lineLength {
+			return &LiquidError{
+				Message: message[:index],
+				Line:    line,
+				Column:  utf8.RuneCountInString(template[lineStart:lineStart+sourceIndex]) + 1,
+			}
+		}
+
+		nextIndex := strings.Index(message[index+1:], " in ")
+		if nextIndex == -1 {
+			break
+		}
+		index += nextIndex + 1
+	}
+
+	return &LiquidError{Message: message, Line: line, Column: 1}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/RJPearson94/twilio-sdk-go/service/studio/v2/flows"
 	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -72,10 +73,18 @@ func resourceStudioFlow() *schema.Resource {
 			},
 			"definition": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"definition", "pinned_revision"},
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: structure.SuppressJsonDiff,
 			},
+			"pinned_revision": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"definition", "pinned_revision"},
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"commit_message": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -111,17 +120,61 @@ func resourceStudioFlow() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.All(
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if _, ok := d.GetOk("pinned_revision"); ok && d.Id() == "" {
+					return fmt.Errorf("pinned_revision can only be set on an existing studio flow, please create the flow using the definition argument first")
+				}
+				return nil
+			},
+			resourceStudioFlowPinnedRevisionDiff,
+		),
 	}
 }
 
+// The definition of the pinned revision is compared with the current definition of the flow, so changes made outside of Terraform are detected and reverted
+func resourceStudioFlowPinnedRevisionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.NewValueKnown("pinned_revision") {
+		return d.SetNewComputed("definition")
+	}
+
+	pinnedRevision, ok := d.GetOk("pinned_revision")
+	if !ok {
+		return nil
+	}
+
+	client := meta.(*common.TwilioClient).Studio
+
+	getResponse, err := client.Flow(d.Id()).Revision(pinnedRevision.(int)).FetchWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed to read studio flow revision (%d): %s", pinnedRevision.(int), err.Error())
+	}
+
+	json, err := structure.FlattenJsonToString(getResponse.Definition)
+	if err != nil {
+		return fmt.Errorf("Unable to flatten definition json to string")
+	}
+	pinnedDefinition, _ := structure.NormalizeJsonString(json)
+
+	currentDefinition, _ := d.GetChange("definition")
+	if normalizedCurrentDefinition, _ := structure.NormalizeJsonString(currentDefinition.(string)); normalizedCurrentDefinition == pinnedDefinition {
+		return nil
+	}
+	return d.SetNew("definition", pinnedDefinition)
+}
+
 func resourceStudioFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).Studio
 
-	if err := validateRequest(ctx, d, meta); err != nil {
+	definitionJSONString, _ := structure.NormalizeJsonString(d.Get("definition").(string))
+	if err := validateRequest(ctx, d, meta, &definitionJSONString); err != nil {
 		return err
 	}
 
-	definitionJSONString, _ := structure.NormalizeJsonString(d.Get("definition").(string))
 	createInput := &flows.CreateFlowInput{
 		FriendlyName:  d.Get("friendly_name").(string),
 		Status:        d.Get("status").(string),
@@ -200,14 +253,21 @@ func resourceStudioFlowRead(ctx context.Context, d *schema.ResourceData, meta in
 func resourceStudioFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).Studio
 
-	if err := validateRequest(ctx, d, meta); err != nil {
+	// The definition is only sent when it has changed, otherwise every update would publish the definition again as a new revision.
+	// When a revision is pinned the definition of the revision is planned by the CustomizeDiff function
+	var definition *string
+	if d.HasChange("definition") {
+		definition = utils.OptionalJSONString(d, "definition")
+	}
+
+	if err := validateRequest(ctx, d, meta, definition); err != nil {
 		return err
 	}
 
 	updateInput := &flow.UpdateFlowInput{
 		FriendlyName:  utils.OptionalString(d, "friendly_name"),
 		Status:        d.Get("status").(string),
-		Definition:    utils.OptionalJSONString(d, "definition"),
+		Definition:    definition,
 		CommitMessage: utils.OptionalString(d, "commit_message"),
 	}
 
@@ -253,15 +313,14 @@ func resourceStudioFlowDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func validateRequest(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.Get("validate").(bool) {
+func validateRequest(ctx context.Context, d *schema.ResourceData, meta interface{}, definition *string) diag.Diagnostics {
+	if d.Get("validate").(bool) && definition != nil {
 		client := meta.(*common.TwilioClient).Studio
 
-		definitionJSONString, _ := structure.NormalizeJsonString(d.Get("definition").(string))
 		validateInput := &flow_validation.ValidateFlowInput{
 			FriendlyName:  d.Get("friendly_name").(string),
 			Status:        d.Get("status").(string),
-			Definition:    definitionJSONString,
+			Definition:    *definition,
 			CommitMessage: utils.OptionalString(d, "commit_message"),
 		}
 
@@ -0,0 +1,128 @@
+package studio
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/service/studio/v2/flow/test_users"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceStudioFlowTestUsers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStudioFlowTestUsersCreate,
+		ReadContext:   resourceStudioFlowTestUsersRead,
+		UpdateContext: resourceStudioFlowTestUsersUpdate,
+		DeleteContext: resourceStudioFlowTestUsersDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				format := "/Flows/(.*)/TestUsers"
+				regex := regexp.MustCompile(format)
+				match := regex.FindStringSubmatch(d.Id())
+
+				if len(match) != 2 {
+					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
+				}
+
+				d.Set("flow_sid", match[1])
+				d.SetId(match[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"flow_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.StudioFlowSidValidation(),
+			},
+			"test_users": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: utils.PhoneNumberValidation(),
+				},
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStudioFlowTestUsersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Test users already exist for each flow so updating the test users
+	return resourceStudioFlowTestUsersUpdate(ctx, d, meta)
+}
+
+func resourceStudioFlowTestUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Studio
+
+	getResponse, err := client.Flow(d.Id()).TestUsers().FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read studio flow test users: %s", err.Error())
+	}
+
+	d.Set("flow_sid", getResponse.Sid)
+	d.Set("test_users", getResponse.TestUsers)
+	d.Set("url", getResponse.URL)
+
+	return nil
+}
+
+func resourceStudioFlowTestUsersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Studio
+
+	updateInput := &test_users.UpdateTestUsersInput{
+		TestUsers: utils.ConvertToStringSlice(d.Get("test_users").(*schema.Set).List()),
+	}
+
+	updateResp, err := client.Flow(d.Get("flow_sid").(string)).TestUsers().UpdateWithContext(ctx, updateInput)
+	if err != nil {
+		return diag.Errorf("Failed to update studio flow test users: %s", err.Error())
+	}
+
+	d.SetId(updateResp.Sid)
+	return resourceStudioFlowTestUsersRead(ctx, d, meta)
+}
+
+func resourceStudioFlowTestUsersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Studio
+
+	// Test users cannot be deleted, so the list of test users is reset instead
+	updateInput := &test_users.UpdateTestUsersInput{
+		TestUsers: []string{},
+	}
+
+	if _, err := client.Flow(d.Id()).TestUsers().UpdateWithContext(ctx, updateInput); err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to reset studio flow test users: %s", err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
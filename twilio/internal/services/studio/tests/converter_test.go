@@ -0,0 +1,297 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/studio/converter"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+func TestConvertStudioFlowDefinition(t *testing.T) {
+	definition := `{"description":"Flow for creating a Flex webchat task","flags":{"allow_concurrent_calls":true},"initial_state":"Trigger","states":[{"name":"Trigger","properties":{"offset":{"x":200,"y":0}},"transitions":[{"event":"incomingCall"},{"event":"incomingMessage","next":"SendMessageToAgent"},{"event":"incomingParent"},{"event":"incomingRequest"}],"type":"trigger"},{"name":"SendMessageToAgent","properties":{"attributes":"{\"name\":\"{{trigger.message.ChannelAttributes.from}}\"}","channel":"TCaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","offset":{"x":270,"y":540},"workflow":"WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},"transitions":[{"event":"callComplete","next":"Trigger"},{"event":"callFailure"},{"event":"failedToEnqueue"}],"type":"send-to-flex"},{"name":"RunSubflow","properties":{"flow_revision":"LatestPublished","flow_sid":"FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","parameters":[{"key":"from","value":"{{trigger.message.From}}"}]},"transitions":[{"event":"completed","next":"CustomWidget"},{"event":"failed"}],"type":"run-subflow"},{"name":"CustomWidget","properties":{"setting":"value"},"transitions":[{"event":"next"}],"type":"custom-widget"}]}`
+
+	expected := `data "twilio_studio_flow_widget_trigger" "trigger" {
+  name = "Trigger"
+
+  transitions {
+    incoming_message = data.twilio_studio_flow_widget_send_to_flex.send_message_to_agent.name
+  }
+
+  offset {
+    x = 200
+    y = 0
+  }
+}
+
+data "twilio_studio_flow_widget_send_to_flex" "send_message_to_agent" {
+  name = "SendMessageToAgent"
+  attributes = jsonencode({
+    name = "{{trigger.message.ChannelAttributes.from}}"
+  })
+  channel_sid  = "TCaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  workflow_sid = "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+  transitions {
+    call_complete = "Trigger"
+  }
+
+  offset {
+    x = 270
+    y = 540
+  }
+}
+
+data "twilio_studio_flow_widget_run_subflow" "run_subflow" {
+  name          = "RunSubflow"
+  flow_revision = "LatestPublished"
+  flow_sid      = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+  parameters {
+    key   = "from"
+    value = "{{trigger.message.From}}"
+  }
+
+  transitions {
+    completed = data.twilio_studio_flow_widget_state.custom_widget.name
+  }
+}
+
+data "twilio_studio_flow_widget_state" "custom_widget" {
+  name = "CustomWidget"
+  type = "custom-widget"
+  properties = {
+    setting = "value"
+  }
+
+  transitions {
+    event = "next"
+  }
+}
+
+data "twilio_studio_flow_definition" "definition" {
+  description   = "Flow for creating a Flex webchat task"
+  initial_state = data.twilio_studio_flow_widget_trigger.trigger.name
+
+  flags {
+    allow_concurrent_calls = true
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_trigger.trigger.json
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_send_to_flex.send_message_to_agent.json
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_run_subflow.run_subflow.json
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_state.custom_widget.json
+  }
+}
+`
+
+	hcl, err := converter.Convert(definition, "definition")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	if hcl != expected {
+		t.Fatalf("Expected HCL:\n%s\nGot:\n%s", expected, hcl)
+	}
+}
+
+func TestConvertStudioFlowDefinition_invalidJSON(t *testing.T) {
+	_, err := converter.Convert("{", "definition")
+	if err == nil || !strings.Contains(err.Error(), "Failed to parse flow definition") {
+		t.Fatalf("Expected parse error, got: %v", err)
+	}
+}
+
+func TestConvertStudioFlowDefinition_stateProperties(t *testing.T) {
+	definition := `{"description":"Custom widget","initial_state":"CustomWidget","states":[{"name":"CustomWidget","properties":{"count":3,"enabled":true,"nested":{"list":["a",1,false],"ratio":1.5},"offset":{"x":100,"y":-50},"setting":"value"},"transitions":[{"event":"next"}],"type":"custom-widget"}]}`
+
+	expected := `data "twilio_studio_flow_widget_state" "custom_widget" {
+  name = "CustomWidget"
+  type = "custom-widget"
+  properties_json = jsonencode({
+    count   = 3
+    enabled = true
+    nested = {
+      list  = ["a", 1, false]
+      ratio = 1.5
+    }
+    offset = {
+      x = 100
+      y = -50
+    }
+    setting = "value"
+  })
+
+  transitions {
+    event = "next"
+  }
+}
+`
+
+	hcl, err := converter.Convert(definition, "definition")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	if !strings.HasPrefix(hcl, expected) {
+		t.Fatalf("Expected HCL to start with:\n%s\nGot:\n%s", expected, hcl)
+	}
+}
+
+func TestConvertStudioFlowDefinition_roundTrip(t *testing.T) {
+	definitions := map[string]string{
+		"typed widgets":    `{"description":"Flow for creating a Flex webchat task","flags":{"allow_concurrent_calls":true},"initial_state":"Trigger","states":[{"name":"Trigger","properties":{"offset":{"x":200,"y":0}},"transitions":[{"event":"incomingCall"},{"event":"incomingMessage","next":"SendMessageToAgent"},{"event":"incomingParent"},{"event":"incomingRequest"}],"type":"trigger"},{"name":"SendMessageToAgent","properties":{"attributes":"{\"name\":\"{{trigger.message.ChannelAttributes.from}}\"}","channel":"TCaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","offset":{"x":270,"y":540},"workflow":"WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},"transitions":[{"event":"callComplete","next":"Trigger"},{"event":"callFailure"},{"event":"failedToEnqueue"}],"type":"send-to-flex"},{"name":"RunSubflow","properties":{"flow_revision":"LatestPublished","flow_sid":"FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","parameters":[{"key":"from","value":"{{trigger.message.From}}"}]},"transitions":[{"event":"completed","next":"CustomWidget"},{"event":"failed"}],"type":"run-subflow"},{"name":"CustomWidget","properties":{"setting":"value"},"transitions":[{"event":"next"}],"type":"custom-widget"}]}`,
+		"new widgets":      `{"description":"Flow with the connect other, send email and connect AI assistant widgets","initial_state":"ConnectOther","states":[{"name":"ConnectOther","properties":{"caller_id":"{{contact.channel.address}}","offset":{"x":10,"y":20},"record":true,"timeout":30,"to":"sip:test@example.com"},"transitions":[{"event":"callCompleted","next":"SendEmail"},{"event":"hangup"}],"type":"connect-other"},{"name":"SendEmail","properties":{"body":"Hello","from":"sender@example.com","offset":{"x":0,"y":0},"subject":"Test","to":"recipient@example.com"},"transitions":[{"event":"failed"},{"event":"sent","next":"ConnectAIAssistant"}],"type":"send-email"},{"name":"ConnectAIAssistant","properties":{"assistant_sid":"aia_asst_00000000-0000-0000-0000-000000000000","identity":"{{contact.channel.address}}","offset":{"x":0,"y":0}},"transitions":[{"event":"completed"},{"event":"failed"}],"type":"connect-ai-assistant"}]}`,
+		"state properties": `{"description":"Custom widget","initial_state":"CustomWidget","states":[{"name":"CustomWidget","properties":{"count":3,"enabled":true,"nested":{"list":["a",1,false],"ratio":1.5},"offset":{"x":100,"y":-50},"setting":"value"},"transitions":[{"event":"next","next":"Split"}],"type":"custom-widget"},{"name":"Split","properties":{"input":"{{trigger.message.Body}}","offset":{"x":0,"y":200}},"transitions":[{"event":"noMatch"},{"conditions":[{"arguments":["{{trigger.message.Body}}"],"friendly_name":"Yes","type":"equal_to","value":"yes"}],"event":"match","next":"CustomWidget"}],"type":"split-based-on"}]}`,
+	}
+
+	for name, definition := range definitions {
+		t.Run(name, func(t *testing.T) {
+			hcl, err := converter.Convert(definition, "definition")
+			if err != nil {
+				t.Fatalf("err: %s", err.Error())
+			}
+
+			output := evaluateFlowDefinition(t, hcl)
+
+			var expectedDefinition, actualDefinition interface{}
+			if err := json.Unmarshal([]byte(definition), &expectedDefinition); err != nil {
+				t.Fatalf("Failed to unmarshal the input definition: %s", err.Error())
+			}
+			if err := json.Unmarshal([]byte(output), &actualDefinition); err != nil {
+				t.Fatalf("Failed to unmarshal the output definition: %s", err.Error())
+			}
+
+			if !reflect.DeepEqual(expectedDefinition, actualDefinition) {
+				t.Fatalf("Expected definition:\n%s\nGot:\n%s\nFrom HCL:\n%s", definition, output, hcl)
+			}
+		})
+	}
+}
+
+// evaluateFlowDefinition reads each data source in the converted HCL, in the same way Terraform would, and returns the JSON of the flow definition
+func evaluateFlowDefinition(t *testing.T, config string) string {
+	file, diags := hclsyntax.ParseConfig([]byte(config), "converted.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("Failed to parse the converted HCL: %s", diags.Error())
+	}
+
+	dataSources := twilio.Provider().DataSourcesMap
+	blocks := file.Body.(*hclsyntax.Body).Blocks
+
+	// The transitions only reference the name of other widgets, so these are resolved before any widget is read
+	values := make(map[string]map[string]map[string]cty.Value)
+	for _, block := range blocks {
+		if _, ok := block.Body.Attributes["name"]; !ok {
+			continue
+		}
+		name, diags := block.Body.Attributes["name"].Expr.Value(nil)
+		if diags.HasErrors() {
+			t.Fatalf("Failed to evaluate the name of %s.%s: %s", block.Labels[0], block.Labels[1], diags.Error())
+		}
+		setDataSourceValue(values, block.Labels[0], block.Labels[1], "name", name)
+	}
+
+	definition := ""
+	for _, block := range blocks {
+		dataSource, ok := dataSources[block.Labels[0]]
+		if !ok {
+			t.Fatalf("The data source (%s) is not supported by the provider", block.Labels[0])
+		}
+
+		evalContext := &hcl.EvalContext{
+			Variables: map[string]cty.Value{
+				"data": dataSourceValues(values),
+			},
+			Functions: map[string]function.Function{
+				"jsonencode": stdlib.JSONEncodeFunc,
+			},
+		}
+
+		d := schema.TestResourceDataRaw(t, dataSource.Schema, decodeBody(t, block.Body, dataSource.Schema, evalContext))
+		if diags := dataSource.ReadContext(context.Background(), d, nil); diags.HasError() {
+			t.Fatalf("Failed to read %s.%s: %v", block.Labels[0], block.Labels[1], diags)
+		}
+
+		setDataSourceValue(values, block.Labels[0], block.Labels[1], "json", cty.StringVal(d.Get("json").(string)))
+		definition = d.Get("json").(string)
+	}
+
+	return definition
+}
+
+func decodeBody(t *testing.T, body *hclsyntax.Body, schemaMap map[string]*schema.Schema, evalContext *hcl.EvalContext) map[string]interface{} {
+	raw := make(map[string]interface{})
+
+	for name, attribute := range body.Attributes {
+		value, diags := attribute.Expr.Value(evalContext)
+		if diags.HasErrors() {
+			t.Fatalf("Failed to evaluate %s: %s", name, diags.Error())
+		}
+
+		valueJSON, err := ctyjson.Marshal(value, value.Type())
+		if err != nil {
+			t.Fatalf("Failed to marshal %s: %s", name, err.Error())
+		}
+
+		var rawValue interface{}
+		if err := json.Unmarshal(valueJSON, &rawValue); err != nil {
+			t.Fatalf("Failed to unmarshal %s: %s", name, err.Error())
+		}
+		raw[name] = rawValue
+	}
+
+	for _, block := range body.Blocks {
+		blockSchema, ok := schemaMap[block.Type]
+		if !ok {
+			t.Fatalf("The block (%s) is not supported", block.Type)
+		}
+
+		items, _ := raw[block.Type].([]interface{})
+		raw[block.Type] = append(items, decodeBody(t, block.Body, blockSchema.Elem.(*schema.Resource).Schema, evalContext))
+	}
+
+	return raw
+}
+
+func setDataSourceValue(values map[string]map[string]map[string]cty.Value, dataSource string, label string, attribute string, value cty.Value) {
+	if values[dataSource] == nil {
+		values[dataSource] = make(map[string]map[string]cty.Value)
+	}
+	if values[dataSource][label] == nil {
+		values[dataSource][label] = make(map[string]cty.Value)
+	}
+	values[dataSource][label][attribute] = value
+}
+
+func dataSourceValues(values map[string]map[string]map[string]cty.Value) cty.Value {
+	dataSources := make(map[string]cty.Value)
+	for dataSource, labels := range values {
+		labelValues := make(map[string]cty.Value)
+		for label, attributes := range labels {
+			labelValues[label] = cty.ObjectVal(attributes)
+		}
+		dataSources[dataSource] = cty.ObjectVal(labelValues)
+	}
+	return cty.ObjectVal(dataSources)
+}
@@ -26,6 +26,28 @@ func TestAccDataSourceTwilioStudioFlowDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceTwilioStudioFlowDefinition_autoLayout(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_definition.definition"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowDefinition_autoLayout(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "auto_layout.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "auto_layout.0.enabled", "true"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "auto_layout.0.horizontal_spacing", "450"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "auto_layout.0.vertical_spacing", "250"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"description":"Flow for creating a Flex webchat task","flags":{"allow_concurrent_calls":true},"initial_state":"Trigger","states":[{"name":"Trigger","properties":{"offset":{"x":200,"y":0}},"transitions":[{"event":"incomingCall"},{"event":"incomingMessage","next":"SendMessageToAgent"},{"event":"incomingParent"},{"event":"incomingRequest"}],"type":"trigger"},{"name":"SendMessageToAgent","properties":{"attributes":"{\"channelSid\":\"{{trigger.message.ChannelSid}}\",\"channelType\":\"{{trigger.message.ChannelAttributes.channel_type}}\",\"name\":\"{{trigger.message.ChannelAttributes.from}}\"}","channel":"TCaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","offset":{"x":0,"y":250},"workflow":"WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},"transitions":[{"event":"callComplete"},{"event":"callFailure"},{"event":"failedToEnqueue"}],"type":"send-to-flex"}]}`),
+					helper.ValidateFlowDefinition(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceTwilioStudioFlowDefinition_basic() string {
 	return `
 data "twilio_studio_flow_widget_send_to_flex" "send_to_flex" {
@@ -76,3 +98,53 @@ data "twilio_studio_flow_definition" "definition" {
 }
 `
 }
+
+func testAccDataSourceTwilioStudioFlowDefinition_autoLayout() string {
+	return `
+data "twilio_studio_flow_widget_send_to_flex" "send_to_flex" {
+  name = "SendMessageToAgent"
+
+  workflow_sid = "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  channel_sid  = "TCaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  attributes = jsonencode({
+    "name" : "{{trigger.message.ChannelAttributes.from}}",
+    "channelType" : "{{trigger.message.ChannelAttributes.channel_type}}",
+    "channelSid" : "{{trigger.message.ChannelSid}}"
+  })
+}
+
+data "twilio_studio_flow_widget_trigger" "trigger" {
+  name = "Trigger"
+
+  transitions {
+    incoming_message = data.twilio_studio_flow_widget_send_to_flex.send_to_flex.name
+  }
+
+  offset {
+    x = 200
+    y = 0
+  }
+}
+
+data "twilio_studio_flow_definition" "definition" {
+  description   = "Flow for creating a Flex webchat task"
+  initial_state = data.twilio_studio_flow_widget_trigger.trigger.name
+
+  flags {
+    allow_concurrent_calls = true
+  }
+
+  auto_layout {
+    enabled = true
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_trigger.trigger.json
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_send_to_flex.send_to_flex.json
+  }
+}
+`
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTwilioStudioFlowGraph_basic(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_graph.flow_graph"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowGraph_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "mermaid", `flowchart TD
+    state0(["Trigger<br/>trigger"])
+    state1["SplitBasedOn<br/>split-based-on"]
+    state2["SendMessage<br/>send-message"]
+    state0 -->|"incomingMessage"| state1
+    state1 -->|"noMatch"| state0
+    state1 -->|"match: If value equal_to yes"| state2
+`),
+					resource.TestCheckResourceAttr(stateDataSourceName, "dot", `digraph {
+  "Trigger" [label="Trigger\ntrigger", shape=oval];
+  "SplitBasedOn" [label="SplitBasedOn\nsplit-based-on", shape=box];
+  "SendMessage" [label="SendMessage\nsend-message", shape=box];
+  "Trigger" -> "SplitBasedOn" [label="incomingMessage"];
+  "SplitBasedOn" -> "Trigger" [label="noMatch"];
+  "SplitBasedOn" -> "SendMessage" [label="match: If value equal_to yes"];
+}
+`),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioFlowGraph_basic() string {
+	return `
+data "twilio_studio_flow_widget_trigger" "trigger" {
+  name = "Trigger"
+
+  transitions {
+    incoming_message = "SplitBasedOn"
+  }
+}
+
+data "twilio_studio_flow_widget_split_based_on" "split_based_on" {
+  name = "SplitBasedOn"
+
+  input = "{{trigger.message.Body}}"
+
+  transitions {
+    no_match = "Trigger"
+
+    matches {
+      conditions {
+        arguments     = ["{{trigger.message.Body}}"]
+        friendly_name = "If value equal_to yes"
+        type          = "equal_to"
+        value         = "yes"
+      }
+      next = "SendMessage"
+    }
+  }
+}
+
+data "twilio_studio_flow_widget_send_message" "send_message" {
+  name = "SendMessage"
+  body = "Thanks"
+}
+
+data "twilio_studio_flow_definition" "definition" {
+  description   = "Test flow graph"
+  initial_state = data.twilio_studio_flow_widget_trigger.trigger.name
+
+  states {
+    json = data.twilio_studio_flow_widget_trigger.trigger.json
+  }
+  states {
+    json = data.twilio_studio_flow_widget_split_based_on.split_based_on.json
+  }
+  states {
+    json = data.twilio_studio_flow_widget_send_message.send_message.json
+  }
+}
+
+data "twilio_studio_flow_graph" "flow_graph" {
+  definition = data.twilio_studio_flow_definition.definition.json
+}
+`
+}
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const revisionDataSourceName = "twilio_studio_flow_revision"
+
+func TestAccDataSourceTwilioStudioFlowRevision_complete(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.revision", revisionDataSourceName)
+	friendlyName := acctest.RandString(10)
+	status := "draft"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowRevision_complete(friendlyName, status),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "flow_sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revision", "1"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "friendly_name", friendlyName),
+					resource.TestCheckResourceAttr(stateDataSourceName, "status", status),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "definition"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "commit_message", "Updated via Terraform"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "valid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "url"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "webhook_url"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "date_created"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowRevision_invalidFlowSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioFlowRevision_invalidFlowSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of flow_sid to match regular expression "\^FW\[0-9a-fA-F\]\{32\}\$", got flow_sid`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowRevision_invalidRevision(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioFlowRevision_invalidRevision(),
+				ExpectError: regexp.MustCompile(`(?s)expected revision to be at least \(1\), got 0`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioFlowRevision_complete(friendlyName string, status string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "%s"
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+
+data "twilio_studio_flow_revision" "revision" {
+  flow_sid = twilio_studio_flow.flow.sid
+  revision = twilio_studio_flow.flow.revision
+}
+`, friendlyName, status)
+}
+
+func testAccDataSourceTwilioStudioFlowRevision_invalidFlowSid() string {
+	return `
+data "twilio_studio_flow_revision" "revision" {
+  flow_sid = "flow_sid"
+  revision = 1
+}
+`
+}
+
+func testAccDataSourceTwilioStudioFlowRevision_invalidRevision() string {
+	return `
+data "twilio_studio_flow_revision" "revision" {
+  flow_sid = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  revision = 0
+}
+`
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const revisionsDataSourceName = "twilio_studio_flow_revisions"
+
+func TestAccDataSourceTwilioStudioFlowRevisions_complete(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.revisions", revisionsDataSourceName)
+	friendlyName := acctest.RandString(10)
+	status := "draft"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowRevisions_complete(friendlyName, status),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "flow_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.0.revision", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.0.friendly_name", friendlyName),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.0.status", status),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "revisions.0.definition"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.0.commit_message", "Updated via Terraform"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "revisions.0.valid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "revisions.0.url"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "revisions.0.date_created"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowRevisions_invalidFlowSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioFlowRevisions_invalidFlowSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of flow_sid to match regular expression "\^FW\[0-9a-fA-F\]\{32\}\$", got flow_sid`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioFlowRevisions_complete(friendlyName string, status string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "%s"
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+
+data "twilio_studio_flow_revisions" "revisions" {
+  flow_sid = twilio_studio_flow.flow.sid
+}
+`, friendlyName, status)
+}
+
+func testAccDataSourceTwilioStudioFlowRevisions_invalidFlowSid() string {
+	return `
+data "twilio_studio_flow_revisions" "revisions" {
+  flow_sid = "flow_sid"
+}
+`
+}
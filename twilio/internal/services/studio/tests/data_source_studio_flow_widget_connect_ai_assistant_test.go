@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/studio/tests/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTwilioStudioFlowWidgetConnectAIAssistant_basic(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_widget_connect_ai_assistant.connect_ai_assistant"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowWidgetConnectAIAssistant_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"name":"ConnectAIAssistant","properties":{"assistant_sid":"aia_asst_00000000-0000-0000-0000-000000000000"},"transitions":[{"event":"completed"},{"event":"failed"}],"type":"connect-ai-assistant"}`),
+					helper.ValidateFlowWidget(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowWidgetConnectAIAssistant_complete(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_widget_connect_ai_assistant.connect_ai_assistant"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowWidgetConnectAIAssistant_complete(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"name":"ConnectAIAssistant","properties":{"assistant_sid":"aia_asst_00000000-0000-0000-0000-000000000000","identity":"{{contact.channel.address}}","offset":{"x":10,"y":20}},"transitions":[{"event":"completed","next":"ConnectAIAssistant"},{"event":"failed","next":"ConnectAIAssistant"}],"type":"connect-ai-assistant"}`),
+					helper.ValidateFlowWidget(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioFlowWidgetConnectAIAssistant_basic() string {
+	return `
+data "twilio_studio_flow_widget_connect_ai_assistant" "connect_ai_assistant" {
+  name          = "ConnectAIAssistant"
+  assistant_sid = "aia_asst_00000000-0000-0000-0000-000000000000"
+}
+`
+}
+
+func testAccDataSourceTwilioStudioFlowWidgetConnectAIAssistant_complete() string {
+	return `
+data "twilio_studio_flow_widget_connect_ai_assistant" "connect_ai_assistant" {
+  name = "ConnectAIAssistant"
+
+  transitions {
+    completed = "ConnectAIAssistant"
+    failed    = "ConnectAIAssistant"
+  }
+
+  assistant_sid = "aia_asst_00000000-0000-0000-0000-000000000000"
+  identity      = "{{contact.channel.address}}"
+
+  offset {
+    x = 10
+    y = 20
+  }
+}
+`
+}
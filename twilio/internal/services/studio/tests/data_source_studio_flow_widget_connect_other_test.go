@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/studio/tests/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTwilioStudioFlowWidgetConnectOther_basic(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_widget_connect_other.connect_other"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowWidgetConnectOther_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"name":"ConnectOther","properties":{"to":"sip:test@example.com"},"transitions":[{"event":"callCompleted"},{"event":"hangup"}],"type":"connect-other"}`),
+					helper.ValidateFlowWidget(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowWidgetConnectOther_complete(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_widget_connect_other.connect_other"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowWidgetConnectOther_complete(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"name":"ConnectOther","properties":{"caller_id":"{{contact.channel.address}}","offset":{"x":10,"y":20},"record":true,"timeout":30,"to":"sip:test@example.com"},"transitions":[{"event":"callCompleted","next":"ConnectOther"},{"event":"hangup","next":"ConnectOther"}],"type":"connect-other"}`),
+					helper.ValidateFlowWidget(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioFlowWidgetConnectOther_basic() string {
+	return `
+data "twilio_studio_flow_widget_connect_other" "connect_other" {
+  name = "ConnectOther"
+  to   = "sip:test@example.com"
+}
+`
+}
+
+func testAccDataSourceTwilioStudioFlowWidgetConnectOther_complete() string {
+	return `
+data "twilio_studio_flow_widget_connect_other" "connect_other" {
+  name = "ConnectOther"
+
+  transitions {
+    call_completed = "ConnectOther"
+    hangup         = "ConnectOther"
+  }
+
+  to        = "sip:test@example.com"
+  caller_id = "{{contact.channel.address}}"
+  record    = true
+  timeout   = 30
+
+  offset {
+    x = 10
+    y = 20
+  }
+}
+`
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/studio/tests/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTwilioStudioFlowWidgetRunSubflow_basic(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_widget_run_subflow.run_subflow"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowWidgetRunSubflow_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"name":"RunSubflow","properties":{"flow_revision":"LatestPublished","flow_sid":"FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},"transitions":[{"event":"completed"},{"event":"failed"}],"type":"run-subflow"}`),
+					helper.ValidateFlowWidget(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowWidgetRunSubflow_complete(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_widget_run_subflow.run_subflow"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowWidgetRunSubflow_complete(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"name":"RunSubflow","properties":{"flow_revision":"2","flow_sid":"FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","offset":{"x":10,"y":20},"parameters":[{"key":"key","value":"value"},{"key":"key2","value":"value2"}]},"transitions":[{"event":"completed","next":"RunSubflow"},{"event":"failed","next":"RunSubflow"}],"type":"run-subflow"}`),
+					helper.ValidateFlowWidget(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioFlowWidgetRunSubflow_basic() string {
+	return `
+data "twilio_studio_flow_widget_run_subflow" "run_subflow" {
+  name     = "RunSubflow"
+  flow_sid = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+}
+`
+}
+
+func testAccDataSourceTwilioStudioFlowWidgetRunSubflow_complete() string {
+	return `
+data "twilio_studio_flow_widget_run_subflow" "run_subflow" {
+  name = "RunSubflow"
+
+  transitions {
+    completed = "RunSubflow"
+    failed    = "RunSubflow"
+  }
+
+  flow_sid      = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  flow_revision = "2"
+  parameters {
+    key   = "key"
+    value = "value"
+  }
+  parameters {
+    key   = "key2"
+    value = "value2"
+  }
+
+  offset {
+    x = 10
+    y = 20
+  }
+}
+`
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/studio/tests/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTwilioStudioFlowWidgetSendEmail_basic(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_widget_send_email.send_email"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowWidgetSendEmail_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"name":"SendEmail","properties":{"body":"Hello World","from":"sender@example.com","to":"recipient@example.com"},"transitions":[{"event":"failed"},{"event":"sent"}],"type":"send-email"}`),
+					helper.ValidateFlowWidget(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowWidgetSendEmail_complete(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_widget_send_email.send_email"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowWidgetSendEmail_complete(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"name":"SendEmail","properties":{"body":"Hello {{contact.channel.address}}","from":"sender@example.com","offset":{"x":10,"y":20},"subject":"Test","to":"recipient@example.com"},"transitions":[{"event":"failed","next":"SendEmail"},{"event":"sent","next":"SendEmail"}],"type":"send-email"}`),
+					helper.ValidateFlowWidget(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioFlowWidgetSendEmail_basic() string {
+	return `
+data "twilio_studio_flow_widget_send_email" "send_email" {
+  name = "SendEmail"
+  from = "sender@example.com"
+  to   = "recipient@example.com"
+  body = "Hello World"
+}
+`
+}
+
+func testAccDataSourceTwilioStudioFlowWidgetSendEmail_complete() string {
+	return `
+data "twilio_studio_flow_widget_send_email" "send_email" {
+  name = "SendEmail"
+
+  transitions {
+    failed = "SendEmail"
+    sent   = "SendEmail"
+  }
+
+  from    = "sender@example.com"
+  to      = "recipient@example.com"
+  subject = "Test"
+  body    = "Hello {{contact.channel.address}}"
+
+  offset {
+    x = 10
+    y = 20
+  }
+}
+`
+}
@@ -95,6 +95,23 @@ func TestAccDataSourceTwilioStudioFlowWidgetState_withMultipleTransitions(t *tes
 	})
 }
 
+func TestAccDataSourceTwilioStudioFlowWidgetState_propertiesJSON(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_widget_state.state"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowWidgetState_propertiesJSON(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"name":"State","properties":{"number_of_digits":4,"offset":{"x":0,"y":200},"stop_gather":true},"transitions":[{"event":"keypress"}],"type":"gather-input-on-call"}`),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceTwilioStudioFlowWidgetState_basic() string {
 	return `
 data "twilio_studio_flow_widget_state" "state" {
@@ -214,3 +231,25 @@ data "twilio_studio_flow_widget_state" "state" {
 }
 `
 }
+
+func testAccDataSourceTwilioStudioFlowWidgetState_propertiesJSON() string {
+	return `
+data "twilio_studio_flow_widget_state" "state" {
+  name = "State"
+  type = "gather-input-on-call"
+
+  transitions {
+    event = "keypress"
+  }
+
+  properties_json = jsonencode({
+    number_of_digits = 4
+    stop_gather      = true
+    offset = {
+      x = 0
+      y = 200
+    }
+  })
+}
+`
+}
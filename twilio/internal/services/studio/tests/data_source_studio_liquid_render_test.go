@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTwilioStudioLiquidRender_basic(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_liquid_render.liquid_render"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioLiquidRender_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "output", "Hello +4471234567890, your order 1234 has been dispatched"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioLiquidRender_undefinedVariable(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_liquid_render.liquid_render"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioLiquidRender_undefinedVariable(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "output", "Hello "),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioLiquidRender_strictVariables(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioLiquidRender_undefinedVariable(true),
+				ExpectError: regexp.MustCompile(`(?s)Failed to render liquid template: undefined variable \(line 1, column 7\)`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioLiquidRender_invalidTemplate(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioLiquidRender_invalidTemplate(),
+				ExpectError: regexp.MustCompile(`(?s)Failed to render liquid template: unterminated "if" block \(line 2, column 1\)`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioLiquidRender_invalidContext(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioLiquidRender_invalidContext(),
+				ExpectError: regexp.MustCompile(`(?s)"contact" contains an invalid JSON`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioLiquidRender_basic() string {
+	return `
+data "twilio_studio_liquid_render" "liquid_render" {
+  template = "Hello {{contact.channel.address}}, your order {{widgets.fetch_order.parsed.id}} has been {{ flow.variables.status | default: 'dispatched' }}"
+
+  contact = jsonencode({
+    channel = {
+      address = "+4471234567890"
+    }
+  })
+  widgets = jsonencode({
+    fetch_order = {
+      parsed = {
+        id = 1234
+      }
+    }
+  })
+  flow = jsonencode({
+    variables = {}
+  })
+}
+`
+}
+
+func testAccDataSourceTwilioStudioLiquidRender_undefinedVariable(strictVariables bool) string {
+	return fmt.Sprintf(`
+data "twilio_studio_liquid_render" "liquid_render" {
+  template         = "Hello {{trigger.message.From}}"
+  strict_variables = %t
+}
+`, strictVariables)
+}
+
+func testAccDataSourceTwilioStudioLiquidRender_invalidTemplate() string {
+	return `
+data "twilio_studio_liquid_render" "liquid_render" {
+  template = "Hello\n{% if contact.channel.address %}{{contact.channel.address}}"
+}
+`
+}
+
+func testAccDataSourceTwilioStudioLiquidRender_invalidContext() string {
+	return `
+data "twilio_studio_liquid_render" "liquid_render" {
+  template = "Hello {{contact.channel.address}}"
+  contact  = "{"
+}
+`
+}
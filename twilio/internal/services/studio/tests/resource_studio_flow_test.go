@@ -8,8 +8,11 @@ import (
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/service/studio/v2/flow"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -197,6 +200,91 @@ func TestAccTwilioStudioFlow_commitMessage(t *testing.T) {
 	})
 }
 
+func TestAccTwilioStudioFlow_pinnedRevision(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.flow", resourceName)
+
+	friendlyName := acctest.RandString(10)
+	status := "published"
+	var flowSid string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioStudioFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioStudioFlow_withDescription(friendlyName, status, "A New Flow"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "1"),
+				),
+			},
+			{
+				Config: testAccTwilioStudioFlow_withDescription(friendlyName, status, "An Updated Flow"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "2"),
+					resource.TestMatchResourceAttr(stateResourceName, "definition", regexp.MustCompile(`"description":"An Updated Flow"`)),
+				),
+			},
+			{
+				Config: testAccTwilioStudioFlow_withPinnedRevision(friendlyName, status, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "pinned_revision", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "3"),
+					resource.TestMatchResourceAttr(stateResourceName, "definition", regexp.MustCompile(`"description":"A New Flow"`)),
+					testAccCheckTwilioStudioFlowSid(stateResourceName, &flowSid),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccTwilioStudioFlowUpdateDescription(t, flowSid, status, "An Out Of Band Flow")
+				},
+				Config: testAccTwilioStudioFlow_withPinnedRevision(friendlyName, status, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "pinned_revision", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "5"),
+					resource.TestMatchResourceAttr(stateResourceName, "definition", regexp.MustCompile(`"description":"A New Flow"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlow_pinnedRevisionOnCreate(t *testing.T) {
+	friendlyName := acctest.RandString(10)
+	status := "published"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlow_withPinnedRevision(friendlyName, status, 1),
+				ExpectError: regexp.MustCompile(`(?s)pinned_revision can only be set on an existing studio flow`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlow_invalidPinnedRevision(t *testing.T) {
+	friendlyName := acctest.RandString(10)
+	status := "published"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlow_withPinnedRevision(friendlyName, status, 0),
+				ExpectError: regexp.MustCompile(`(?s)expected pinned_revision to be at least \(1\), got 0`),
+			},
+		},
+	})
+}
+
 func TestAccTwilioStudioFlow_withInvalidFlow(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -247,6 +335,40 @@ func testAccCheckTwilioStudioFlowExists(name string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckTwilioStudioFlowSid(name string, sid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*sid = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccTwilioStudioFlowUpdateDescription(t *testing.T, sid string, status string, description string) {
+	client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Studio
+
+	getResponse, err := client.Flow(sid).Fetch()
+	if err != nil {
+		t.Fatalf("Error occurred when retrieving flow information %s", err.Error())
+	}
+
+	getResponse.Definition["description"] = description
+	definition, err := structure.FlattenJsonToString(getResponse.Definition)
+	if err != nil {
+		t.Fatalf("Unable to flatten definition json to string")
+	}
+
+	if _, err := client.Flow(sid).Update(&flow.UpdateFlowInput{
+		Status:     status,
+		Definition: sdkUtils.String(definition),
+	}); err != nil {
+		t.Fatalf("Error occurred when updating flow %s", err.Error())
+	}
+}
+
 func testAccTwilioStudioFlowImportStateIdFunc(name string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[name]
@@ -317,6 +439,45 @@ resource "twilio_studio_flow" "flow" {
 `, friendlyName, status, commitMessage)
 }
 
+func testAccTwilioStudioFlow_withDescription(friendlyName string, status string, description string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "%s"
+  definition = jsonencode({
+    "description" : "%s",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+`, friendlyName, status, description)
+}
+
+func testAccTwilioStudioFlow_withPinnedRevision(friendlyName string, status string, pinnedRevision int) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name   = "%s"
+  status          = "%s"
+  pinned_revision = %d
+}
+`, friendlyName, status, pinnedRevision)
+}
+
 func testAccTwilioStudioFlow_withWidgets() string {
 	return `
 data "twilio_studio_flow_widget_trigger" "trigger" {
@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var flowTestUsersResourceName = "twilio_studio_flow_test_users"
+
+func TestAccTwilioStudioFlowTestUsers_basic(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.test_users", flowTestUsersResourceName)
+	friendlyName := acctest.RandString(10)
+	testUsers := []string{"+14155551234"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioStudioFlowTestUsers_basic(friendlyName, testUsers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowTestUsersExists(stateResourceName),
+					resource.TestCheckResourceAttrSet(stateResourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "flow_sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "test_users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(stateResourceName, "test_users.*", "+14155551234"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "url"),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioStudioFlowTestUsersImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlowTestUsers_update(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.test_users", flowTestUsersResourceName)
+	friendlyName := acctest.RandString(10)
+	testUsers := []string{"+14155551234"}
+	newTestUsers := []string{"+14155551234", "+14155555678"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioStudioFlowTestUsers_basic(friendlyName, testUsers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowTestUsersExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "test_users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(stateResourceName, "test_users.*", "+14155551234"),
+				),
+			},
+			{
+				Config: testAccTwilioStudioFlowTestUsers_basic(friendlyName, newTestUsers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowTestUsersExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "test_users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(stateResourceName, "test_users.*", "+14155551234"),
+					resource.TestCheckTypeSetElemAttr(stateResourceName, "test_users.*", "+14155555678"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlowTestUsers_invalidFlowSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlowTestUsers_invalidFlowSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of flow_sid to match regular expression "\^FW\[0-9a-fA-F\]\{32\}\$", got flow_sid`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlowTestUsers_invalidTestUser(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlowTestUsers_invalidTestUser(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of test_users.0 to match regular expression "\^\\\\\+\[1-9\]\\\\d\{1,14\}\$", got 4155551234`),
+			},
+		},
+	})
+}
+
+func testAccCheckTwilioStudioFlowTestUsersExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Studio
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if _, err := client.Flow(rs.Primary.ID).TestUsers().Fetch(); err != nil {
+			return fmt.Errorf("Error occurred when retrieving flow test users information %s", err.Error())
+		}
+
+		return nil
+	}
+}
+
+func testAccTwilioStudioFlowTestUsersImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("/Flows/%s/TestUsers", rs.Primary.Attributes["flow_sid"]), nil
+	}
+}
+
+func testAccTwilioStudioFlowTestUsers_basic(friendlyName string, testUsers []string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "draft"
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+
+resource "twilio_studio_flow_test_users" "test_users" {
+  flow_sid   = twilio_studio_flow.flow.sid
+  test_users = %s
+}
+`, friendlyName, `["`+strings.Join(testUsers, `","`)+`"]`)
+}
+
+func testAccTwilioStudioFlowTestUsers_invalidFlowSid() string {
+	return `
+resource "twilio_studio_flow_test_users" "test_users" {
+  flow_sid   = "flow_sid"
+  test_users = ["+14155551234"]
+}
+`
+}
+
+func testAccTwilioStudioFlowTestUsers_invalidTestUser() string {
+	return `
+resource "twilio_studio_flow_test_users" "test_users" {
+  flow_sid   = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  test_users = ["4155551234"]
+}
+`
+}
@@ -0,0 +1,213 @@
+package taskrouter
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTaskRouterRoutingSimulation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTaskRouterRoutingSimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"workflow_configuration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.All(validation.StringIsJSON, helper.WorkflowConfigurationValidation()),
+			},
+			"task_queues": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: utils.TaskRouterTaskQueueSidValidation(),
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"target_workers": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "1==1",
+							ValidateFunc: helper.ExpressionValidation(helper.WorkerExpressionContext),
+						},
+					},
+				},
+			},
+			"tasks": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"attributes": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+					},
+				},
+			},
+			"workers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: utils.TaskRouterWorkerSidValidation(),
+						},
+						"friendly_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"attributes": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+					},
+				},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filter_friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_filter": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"targets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"queue_sid": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"queue_friendly_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"eligible_workers": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskRouterRoutingSimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	configuration, err := helper.ParseWorkflowConfiguration(d.Get("workflow_configuration").(string))
+	if err != nil {
+		return diag.Errorf("Failed to parse workflow configuration: %s", err.Error())
+	}
+
+	taskQueues := []helper.SimulationTaskQueue{}
+	for _, taskQueue := range d.Get("task_queues").([]interface{}) {
+		taskQueueMap := taskQueue.(map[string]interface{})
+		taskQueues = append(taskQueues, helper.SimulationTaskQueue{
+			Sid:           taskQueueMap["sid"].(string),
+			FriendlyName:  taskQueueMap["friendly_name"].(string),
+			TargetWorkers: taskQueueMap["target_workers"].(string),
+		})
+	}
+
+	tasks := []helper.SimulationTask{}
+	for _, task := range d.Get("tasks").([]interface{}) {
+		taskMap := task.(map[string]interface{})
+
+		attributes := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(taskMap["attributes"].(string)), &attributes); err != nil {
+			return diag.Errorf("Failed to unmarshal attributes of task (%s): %s", taskMap["name"].(string), err.Error())
+		}
+
+		tasks = append(tasks, helper.SimulationTask{
+			Name:       taskMap["name"].(string),
+			Attributes: attributes,
+		})
+	}
+
+	workers := []helper.SimulationWorker{}
+	for _, worker := range d.Get("workers").([]interface{}) {
+		workerMap := worker.(map[string]interface{})
+
+		attributes := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(workerMap["attributes"].(string)), &attributes); err != nil {
+			return diag.Errorf("Failed to unmarshal attributes of worker (%s): %s", workerMap["friendly_name"].(string), err.Error())
+		}
+
+		workers = append(workers, helper.SimulationWorker{
+			Sid:          workerMap["sid"].(string),
+			FriendlyName: workerMap["friendly_name"].(string),
+			Attributes:   attributes,
+		})
+	}
+
+	simulationResults, err := helper.SimulateRouting(configuration, taskQueues, tasks, workers)
+	if err != nil {
+		return diag.Errorf("Failed to simulate routing: %s", err.Error())
+	}
+
+	results := make([]interface{}, 0)
+	for _, simulationResult := range simulationResults {
+		targets := make([]interface{}, 0)
+		for _, target := range simulationResult.Targets {
+			targets = append(targets, map[string]interface{}{
+				"queue_sid":           target.QueueSid,
+				"queue_friendly_name": target.QueueFriendlyName,
+				"eligible_workers":    target.EligibleWorkers,
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"task_name":            simulationResult.TaskName,
+			"filter_friendly_name": simulationResult.FilterFriendlyName,
+			"default_filter":       simulationResult.DefaultFilter,
+			"targets":              targets,
+		})
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("results", results)
+
+	return nil
+}
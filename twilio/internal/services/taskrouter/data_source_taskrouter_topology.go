@@ -0,0 +1,391 @@
+package taskrouter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTaskRouterTopology() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTaskRouterTopologyRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.TaskRouterWorkspaceSidValidation(),
+			},
+			"activities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"available": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"workers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"activity_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"task_queues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_workers": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_workers_error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"worker_sids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"workflows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"configuration_error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filters": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter_friendly_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"expression": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"targets": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"queue_sid": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"expression": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"priority": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"timeout": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"default_filter_queue_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"mermaid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dot": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTaskRouterTopologyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	twilioClient := meta.(*common.TwilioClient)
+	client := twilioClient.TaskRouter
+
+	workspaceSid := d.Get("workspace_sid").(string)
+	workspaceClient := client.Workspace(workspaceSid)
+
+	activitiesPaginator := workspaceClient.Activities.NewActivitiesPaginator()
+	for activitiesPaginator.NextWithContext(ctx) {
+	}
+	if err := activitiesPaginator.Error(); err != nil {
+		if utils.IsNotFoundError(err) {
+			return diag.Errorf("TaskRouter workspace with sid (%s) was not found", workspaceSid)
+		}
+		return diag.Errorf("Failed to read taskrouter activities: %s", err.Error())
+	}
+
+	activities := []helper.TopologyActivity{}
+	for _, activity := range activitiesPaginator.Activities {
+		activities = append(activities, helper.TopologyActivity{
+			Sid:          activity.Sid,
+			FriendlyName: activity.FriendlyName,
+			Available:    activity.Available,
+		})
+	}
+
+	workersPaginator := workspaceClient.Workers.NewWorkersPaginator()
+	for workersPaginator.NextWithContext(ctx) {
+	}
+	if err := workersPaginator.Error(); err != nil {
+		return diag.Errorf("Failed to read taskrouter workers: %s", err.Error())
+	}
+
+	workers := []helper.TopologyWorker{}
+	workerAttributes := make(map[string]string)
+	for _, worker := range workersPaginator.Workers {
+		attributes := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(worker.Attributes), &attributes); err != nil {
+			return diag.Errorf("Failed to unmarshal attributes of worker (%s): %s", worker.Sid, err.Error())
+		}
+
+		workerAttributes[worker.Sid] = worker.Attributes
+		workers = append(workers, helper.TopologyWorker{
+			Sid:          worker.Sid,
+			FriendlyName: worker.FriendlyName,
+			ActivitySid:  worker.ActivitySid,
+			Attributes:   attributes,
+		})
+	}
+
+	taskQueuesPaginator := workspaceClient.TaskQueues.NewTaskQueuesPaginator()
+	for taskQueuesPaginator.NextWithContext(ctx) {
+	}
+	if err := taskQueuesPaginator.Error(); err != nil {
+		return diag.Errorf("Failed to read taskrouter task queues: %s", err.Error())
+	}
+
+	taskQueues := []helper.TopologyTaskQueue{}
+	for _, taskQueue := range taskQueuesPaginator.TaskQueues {
+		taskQueues = append(taskQueues, helper.TopologyTaskQueue{
+			Sid:           taskQueue.Sid,
+			FriendlyName:  taskQueue.FriendlyName,
+			TargetWorkers: helper.FlattenOptionalString(taskQueue.TargetWorkers),
+		})
+	}
+
+	workflowsPaginator := workspaceClient.Workflows.NewWorkflowsPaginator()
+	for workflowsPaginator.NextWithContext(ctx) {
+	}
+	if err := workflowsPaginator.Error(); err != nil {
+		return diag.Errorf("Failed to read taskrouter workflows: %s", err.Error())
+	}
+
+	workflows := []helper.TopologyWorkflow{}
+	for _, workflow := range workflowsPaginator.Workflows {
+		topologyWorkflow := helper.TopologyWorkflow{
+			Sid:          workflow.Sid,
+			FriendlyName: workflow.FriendlyName,
+		}
+
+		configuration, err := helper.ParseWorkflowConfiguration(workflow.Configuration)
+		if err != nil {
+			topologyWorkflow.ConfigurationError = err.Error()
+		} else {
+			topologyWorkflow.Configuration = configuration
+		}
+
+		workflows = append(workflows, topologyWorkflow)
+	}
+
+	topology := helper.BuildTopology(activities, taskQueues, workers, workflows)
+
+	d.SetId(workspaceSid)
+	d.Set("workspace_sid", workspaceSid)
+	d.Set("account_sid", twilioClient.AccountSid)
+	d.Set("activities", flattenTopologyActivities(topology.Activities))
+	d.Set("workers", flattenTopologyWorkers(topology.Workers, workerAttributes))
+	d.Set("task_queues", flattenTopologyTaskQueues(topology.TaskQueues))
+	d.Set("workflows", flattenTopologyWorkflows(topology.Workflows))
+	d.Set("mermaid", topology.Mermaid())
+	d.Set("dot", topology.DOT())
+
+	var diags diag.Diagnostics
+	for _, taskQueue := range topology.TaskQueues {
+		if taskQueue.TargetWorkersError != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to parse the target workers expression of task queue (%s)", taskQueue.Sid),
+				Detail:   fmt.Sprintf("No workers have been matched to the task queue: %s", taskQueue.TargetWorkersError),
+			})
+		}
+	}
+	for _, workflow := range topology.Workflows {
+		if workflow.ConfigurationError != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to parse the configuration of workflow (%s)", workflow.Sid),
+				Detail:   fmt.Sprintf("The filters of the workflow have not been included in the topology: %s", workflow.ConfigurationError),
+			})
+		}
+	}
+	return diags
+}
+
+func flattenTopologyActivities(activities []helper.TopologyActivity) []interface{} {
+	results := make([]interface{}, 0)
+	for _, activity := range activities {
+		results = append(results, map[string]interface{}{
+			"sid":           activity.Sid,
+			"friendly_name": activity.FriendlyName,
+			"available":     activity.Available,
+		})
+	}
+	return results
+}
+
+func flattenTopologyWorkers(workers []helper.TopologyWorker, attributes map[string]string) []interface{} {
+	results := make([]interface{}, 0)
+	for _, worker := range workers {
+		results = append(results, map[string]interface{}{
+			"sid":           worker.Sid,
+			"friendly_name": worker.FriendlyName,
+			"activity_sid":  worker.ActivitySid,
+			"attributes":    attributes[worker.Sid],
+		})
+	}
+	return results
+}
+
+func flattenTopologyTaskQueues(taskQueues []helper.TopologyTaskQueue) []interface{} {
+	results := make([]interface{}, 0)
+	for _, taskQueue := range taskQueues {
+		results = append(results, map[string]interface{}{
+			"sid":                  taskQueue.Sid,
+			"friendly_name":        taskQueue.FriendlyName,
+			"target_workers":       taskQueue.TargetWorkers,
+			"target_workers_error": taskQueue.TargetWorkersError,
+			"worker_sids":          taskQueue.WorkerSids,
+		})
+	}
+	return results
+}
+
+func flattenTopologyWorkflows(workflows []helper.TopologyWorkflow) []interface{} {
+	results := make([]interface{}, 0)
+	for _, workflow := range workflows {
+		filters := make([]interface{}, 0)
+		defaultFilterQueueSid := ""
+		if workflow.Configuration != nil {
+			filters = flattenTopologyWorkflowFilters(workflow.Configuration.TaskRouting.Filters)
+			if workflow.Configuration.TaskRouting.DefaultFilter != nil {
+				defaultFilterQueueSid = workflow.Configuration.TaskRouting.DefaultFilter.Queue
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"sid":                      workflow.Sid,
+			"friendly_name":            workflow.FriendlyName,
+			"configuration_error":      workflow.ConfigurationError,
+			"filters":                  filters,
+			"default_filter_queue_sid": defaultFilterQueueSid,
+		})
+	}
+	return results
+}
+
+func flattenTopologyWorkflowFilters(workflowFilters []helper.WorkflowFilter) []interface{} {
+	filters := make([]interface{}, 0)
+	for _, filter := range workflowFilters {
+		targets := make([]interface{}, 0)
+		for _, target := range filter.Targets {
+			targets = append(targets, map[string]interface{}{
+				"queue_sid":  target.Queue,
+				"expression": helper.FlattenOptionalString(target.Expression),
+				"priority":   helper.FlattenOptionalInt(target.Priority),
+				"timeout":    helper.FlattenOptionalInt(target.Timeout),
+			})
+		}
+
+		filters = append(filters, map[string]interface{}{
+			"filter_friendly_name": filter.FilterFriendlyName,
+			"expression":           filter.Expression,
+			"targets":              targets,
+		})
+	}
+	return filters
+}
@@ -0,0 +1,198 @@
+package taskrouter
+
+import (
+	"context"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/worker/statistics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTaskRouterWorkerStatistics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTaskRouterWorkerStatisticsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.TaskRouterWorkspaceSidValidation(),
+			},
+			"worker_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.TaskRouterWorkerSidValidation(),
+			},
+			"task_channel": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"minutes": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"start_date"},
+			},
+			"start_date": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"minutes"},
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cumulative": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"activity_durations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sid": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"friendly_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"avg": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"total": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"reservations_accepted": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_canceled": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_completed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_created": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_rejected": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_rescinded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_timed_out": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_wrapup": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tasks_assigned": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskRouterWorkerStatisticsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).TaskRouter
+
+	workspaceSid := d.Get("workspace_sid").(string)
+	workerSid := d.Get("worker_sid").(string)
+
+	getResponse, err := client.Workspace(workspaceSid).Worker(workerSid).Statistics().FetchWithContext(ctx, &statistics.FetchStatisticsOptions{
+		Minutes:     utils.OptionalInt(d, "minutes"),
+		StartDate:   helper.OptionalStatisticsTime(d, "start_date"),
+		EndDate:     helper.OptionalStatisticsTime(d, "end_date"),
+		TaskChannel: utils.OptionalString(d, "task_channel"),
+	})
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return diag.Errorf("Worker with sid (%s) was not found for taskrouter workspace with sid (%s)", workerSid, workspaceSid)
+		}
+		return diag.Errorf("Failed to read worker statistics: %s", err.Error())
+	}
+
+	d.SetId(getResponse.WorkerSid)
+	d.Set("workspace_sid", getResponse.WorkspaceSid)
+	d.Set("worker_sid", getResponse.WorkerSid)
+	d.Set("account_sid", getResponse.AccountSid)
+
+	cumulative := getResponse.Cumulative
+
+	activityDurations := make([]interface{}, 0)
+	for _, activityDuration := range cumulative.ActivityDurations {
+		activityDurations = append(activityDurations, helper.FlattenActivityDuration(activityDuration.Sid, activityDuration.FriendlyName, activityDuration.Avg, activityDuration.Max, activityDuration.Min, activityDuration.Total))
+	}
+
+	d.Set("cumulative", []interface{}{
+		map[string]interface{}{
+			"start_time":             cumulative.StartTime.Format(time.RFC3339),
+			"end_time":               cumulative.EndTime.Format(time.RFC3339),
+			"activity_durations":     activityDurations,
+			"reservations_accepted":  cumulative.ReservationsAccepted,
+			"reservations_canceled":  cumulative.ReservationsCanceled,
+			"reservations_completed": cumulative.ReservationsCompleted,
+			"reservations_created":   cumulative.ReservationsCreated,
+			"reservations_rejected":  cumulative.ReservationsRejected,
+			"reservations_rescinded": cumulative.ReservationsRescinded,
+			"reservations_timed_out": cumulative.ReservationsTimedOut,
+			"reservations_wrapup":    cumulative.ReservationsWrapUp,
+			"tasks_assigned":         cumulative.TasksAssigned,
+		},
+	})
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
 	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/workers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -43,8 +44,9 @@ func dataSourceTaskRouterWorkers() *schema.Resource {
 				Optional: true,
 			},
 			"target_workers_expression": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: helper.ExpressionValidationAsWarnings(helper.WorkerExpressionContext),
 			},
 			"task_queue_name": {
 				Type:     schema.TypeString,
@@ -0,0 +1,166 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTaskRouterWorkflowConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTaskRouterWorkflowConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"friendly_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: helper.ExpressionValidation(helper.TaskExpressionContext),
+						},
+						"targets": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"queue": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: utils.TaskRouterTaskQueueSidValidation(),
+									},
+									"expression": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: helper.ExpressionValidation(helper.TargetExpressionContext),
+									},
+									"priority": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"timeout": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"order_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"skip_if": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"known_worker_sid": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"known_worker_friendly_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"default_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"queue": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: utils.TaskRouterTaskQueueSidValidation(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskRouterWorkflowConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filters := []helper.WorkflowFilter{}
+	for filterIndex, filter := range d.Get("filters").([]interface{}) {
+		filterMap := filter.(map[string]interface{})
+
+		targets := []helper.WorkflowTarget{}
+		for targetIndex := range filterMap["targets"].([]interface{}) {
+			targetKey := fmt.Sprintf("filters.%d.targets.%d", filterIndex, targetIndex)
+
+			target := helper.WorkflowTarget{
+				Queue:                   d.Get(targetKey + ".queue").(string),
+				Expression:              utils.OptionalString(d, targetKey+".expression"),
+				Priority:                utils.OptionalInt(d, targetKey+".priority"),
+				Timeout:                 utils.OptionalInt(d, targetKey+".timeout"),
+				OrderBy:                 utils.OptionalString(d, targetKey+".order_by"),
+				SkipIf:                  utils.OptionalString(d, targetKey+".skip_if"),
+				KnownWorkerSid:          utils.OptionalString(d, targetKey+".known_worker_sid"),
+				KnownWorkerFriendlyName: utils.OptionalString(d, targetKey+".known_worker_friendly_name"),
+			}
+
+			if target.KnownWorkerSid != nil && target.KnownWorkerFriendlyName != nil {
+				return diag.Errorf("Only one of known_worker_sid or known_worker_friendly_name can be set on target %d of filter %d", targetIndex, filterIndex)
+			}
+
+			targets = append(targets, target)
+		}
+
+		filters = append(filters, helper.WorkflowFilter{
+			FilterFriendlyName: filterMap["friendly_name"].(string),
+			Expression:         filterMap["expression"].(string),
+			Targets:            targets,
+		})
+	}
+
+	var defaultFilter *helper.WorkflowDefaultFilter
+	if _, ok := d.GetOk("default_filter"); ok {
+		defaultFilter = &helper.WorkflowDefaultFilter{
+			Queue: d.Get("default_filter.0.queue").(string),
+		}
+	}
+
+	configuration := helper.WorkflowConfiguration{
+		TaskRouting: helper.WorkflowTaskRouting{
+			Filters:       filters,
+			DefaultFilter: defaultFilter,
+		},
+	}
+
+	json, err := configuration.ToString()
+	if err != nil {
+		return diag.Errorf("Failed to marshal workflow configuration to JSON: %s", err.Error())
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("json", json)
+
+	return nil
+}
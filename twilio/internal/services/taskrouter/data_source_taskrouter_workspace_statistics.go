@@ -0,0 +1,390 @@
+package taskrouter
+
+import (
+	"context"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/cumulative_statistics"
+	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/real_time_statistics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTaskRouterWorkspaceStatistics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTaskRouterWorkspaceStatisticsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.TaskRouterWorkspaceSidValidation(),
+			},
+			"task_channel": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"minutes": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"start_date"},
+			},
+			"start_date": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"minutes"},
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"split_by_wait_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"real_time": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"activity_statistics": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sid": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"friendly_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"workers": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"longest_task_waiting_age": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"longest_task_waiting_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tasks_by_priority": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"tasks_by_status": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"total_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_workers": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"cumulative": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"avg_task_acceptance_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_accepted": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_canceled": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_completed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_created": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_rejected": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_rescinded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_timed_out": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservations_wrapup": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tasks_assigned": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tasks_canceled": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tasks_completed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tasks_created": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tasks_deleted": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tasks_moved": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tasks_timed_out_in_workflow": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"wait_duration_until_accepted": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"avg": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"total": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"wait_duration_until_canceled": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"avg": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"total": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"split_by_wait_time": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"wait_time": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"above": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"reservations_accepted": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"tasks_canceled": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"below": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"reservations_accepted": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"tasks_canceled": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskRouterWorkspaceStatisticsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).TaskRouter
+
+	workspaceSid := d.Get("workspace_sid").(string)
+	workspaceClient := client.Workspace(workspaceSid)
+
+	realTimeResponse, err := workspaceClient.RealTimeStatistics().FetchWithContext(ctx, &real_time_statistics.FetchRealTimeStatisticsOptions{
+		TaskChannel: utils.OptionalString(d, "task_channel"),
+	})
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return diag.Errorf("TaskRouter workspace with sid (%s) was not found", workspaceSid)
+		}
+		return diag.Errorf("Failed to read taskrouter workspace real time statistics: %s", err.Error())
+	}
+
+	cumulativeResponse, err := workspaceClient.CumulativeStatistics().FetchWithContext(ctx, &cumulative_statistics.FetchCumulativeStatisticsOptions{
+		Minutes:         utils.OptionalInt(d, "minutes"),
+		StartDate:       helper.OptionalStatisticsTime(d, "start_date"),
+		EndDate:         helper.OptionalStatisticsTime(d, "end_date"),
+		TaskChannel:     utils.OptionalString(d, "task_channel"),
+		SplitByWaitTime: helper.OptionalSplitByWaitTime(d),
+	})
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return diag.Errorf("TaskRouter workspace with sid (%s) was not found", workspaceSid)
+		}
+		return diag.Errorf("Failed to read taskrouter workspace cumulative statistics: %s", err.Error())
+	}
+
+	d.SetId(realTimeResponse.WorkspaceSid)
+	d.Set("workspace_sid", realTimeResponse.WorkspaceSid)
+	d.Set("account_sid", realTimeResponse.AccountSid)
+
+	activityStatistics := make([]interface{}, 0)
+	for _, activityStatistic := range realTimeResponse.ActivityStatistics {
+		activityStatistics = append(activityStatistics, helper.FlattenActivityStatistic(activityStatistic.Sid, activityStatistic.FriendlyName, activityStatistic.Workers))
+	}
+
+	d.Set("real_time", []interface{}{
+		map[string]interface{}{
+			"activity_statistics":      activityStatistics,
+			"longest_task_waiting_age": realTimeResponse.LongestTaskWaitingAge,
+			"longest_task_waiting_sid": helper.FlattenOptionalString(realTimeResponse.LongestTaskWaitingSid),
+			"tasks_by_priority":        helper.FlattenIntMap(realTimeResponse.TasksByPriority),
+			"tasks_by_status":          helper.FlattenIntMap(realTimeResponse.TasksByStatus),
+			"total_tasks":              realTimeResponse.TotalTasks,
+			"total_workers":            realTimeResponse.TotalWorkers,
+		},
+	})
+
+	splitByWaitTime := make([]interface{}, 0)
+	if cumulativeResponse.SplitByWaitTime != nil {
+		for waitTime, waitTimeStatistics := range *cumulativeResponse.SplitByWaitTime {
+			splitByWaitTime = append(splitByWaitTime, helper.FlattenWaitTime(
+				waitTime,
+				waitTimeStatistics.Above.ReservationsAccepted,
+				waitTimeStatistics.Above.TasksCanceled,
+				waitTimeStatistics.Below.ReservationsAccepted,
+				waitTimeStatistics.Below.TasksCanceled,
+			))
+		}
+	}
+
+	d.Set("cumulative", []interface{}{
+		map[string]interface{}{
+			"start_time":                   cumulativeResponse.StartTime.Format(time.RFC3339),
+			"end_time":                     cumulativeResponse.EndTime.Format(time.RFC3339),
+			"avg_task_acceptance_time":     cumulativeResponse.AvgTaskAcceptanceTime,
+			"reservations_accepted":        cumulativeResponse.ReservationsAccepted,
+			"reservations_canceled":        cumulativeResponse.ReservationsCanceled,
+			"reservations_completed":       helper.FlattenOptionalInt(cumulativeResponse.ReservationsCompleted),
+			"reservations_created":         cumulativeResponse.ReservationsCreated,
+			"reservations_rejected":        cumulativeResponse.ReservationsRejected,
+			"reservations_rescinded":       cumulativeResponse.ReservationsRescinded,
+			"reservations_timed_out":       cumulativeResponse.ReservationsTimedOut,
+			"reservations_wrapup":          cumulativeResponse.ReservationsWrapUp,
+			"tasks_assigned":               helper.FlattenOptionalInt(cumulativeResponse.TasksAssigned),
+			"tasks_canceled":               cumulativeResponse.TasksCanceled,
+			"tasks_completed":              cumulativeResponse.TasksCompleted,
+			"tasks_created":                cumulativeResponse.TasksCreated,
+			"tasks_deleted":                cumulativeResponse.TasksDeleted,
+			"tasks_moved":                  cumulativeResponse.TasksMoved,
+			"tasks_timed_out_in_workflow":  cumulativeResponse.TasksTimedOutInWorkflow,
+			"wait_duration_until_accepted": helper.FlattenStatisticsBreakdown(cumulativeResponse.WaitDurationUntilAccepted.Avg, cumulativeResponse.WaitDurationUntilAccepted.Max, cumulativeResponse.WaitDurationUntilAccepted.Min, cumulativeResponse.WaitDurationUntilAccepted.Total),
+			"wait_duration_until_canceled": helper.FlattenStatisticsBreakdown(cumulativeResponse.WaitDurationUntilCanceled.Avg, cumulativeResponse.WaitDurationUntilCanceled.Max, cumulativeResponse.WaitDurationUntilCanceled.Min, cumulativeResponse.WaitDurationUntilCanceled.Total),
+			"split_by_wait_time":           helper.SortWaitTimes(splitByWaitTime),
+		},
+	})
+
+	return nil
+}
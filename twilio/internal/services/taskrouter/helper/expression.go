@@ -0,0 +1,411 @@
+package helper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Expression is a node of a parsed TaskRouter expression.
+// See https://www.twilio.com/docs/taskrouter/expression-syntax for more information
+type Expression interface {
+	expression()
+}
+
+// LogicalExpression joins two expressions using either the AND or OR operator
+type LogicalExpression struct {
+	Operator string
+	Left     Expression
+	Right    Expression
+}
+
+// ComparisonExpression compares two operands using one of the comparison operators (==, !=, >, >=, <, <=, HAS, IN, NOT IN, CONTAINS)
+type ComparisonExpression struct {
+	Operator string
+	Left     Operand
+	Right    Operand
+}
+
+// OperandExpression is an operand which is used without a comparison, i.e. an attribute containing a boolean value
+type OperandExpression struct {
+	Operand Operand
+}
+
+func (LogicalExpression) expression()    {}
+func (ComparisonExpression) expression() {}
+func (OperandExpression) expression()    {}
+
+// Operand is a value which can be compared in a TaskRouter expression
+type Operand interface {
+	operand()
+}
+
+// AttributeOperand is a reference to an attribute, i.e. task.language or worker.skills
+type AttributeOperand struct {
+	Path     []string
+	Position int
+}
+
+// LiteralOperand is a string, number (float64), boolean or null (nil) value
+type LiteralOperand struct {
+	Value interface{}
+}
+
+// ListOperand is a list of operands, i.e. ['english', 'spanish']
+type ListOperand struct {
+	Values []Operand
+}
+
+func (AttributeOperand) operand() {}
+func (LiteralOperand) operand()   {}
+func (ListOperand) operand()      {}
+
+// Name returns the dot separated path of the attribute
+func (attribute AttributeOperand) Name() string {
+	return strings.Join(attribute.Path, ".")
+}
+
+// ExpressionError is a syntax error in a TaskRouter expression. The position is the 1-based character offset of the error in the expression
+type ExpressionError struct {
+	Message  string
+	Position int
+}
+
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+type expressionTokenType int
+
+const (
+	endToken expressionTokenType = iota
+	identifierToken
+	stringToken
+	numberToken
+	operatorToken
+	openParenthesisToken
+	closeParenthesisToken
+	openBracketToken
+	closeBracketToken
+	commaToken
+)
+
+type expressionToken struct {
+	tokenType expressionTokenType
+	value     string
+	position  int
+}
+
+func (token expressionToken) String() string {
+	switch token.tokenType {
+	case endToken:
+		return "end of expression"
+	case stringToken:
+		return fmt.Sprintf("string %q", token.value)
+	default:
+		return fmt.Sprintf("%q", token.value)
+	}
+}
+
+var comparisonOperators = map[string]string{
+	"==":       "==",
+	"=":        "==",
+	"!=":       "!=",
+	">":        ">",
+	">=":       ">=",
+	"<":        "<",
+	"<=":       "<=",
+	"HAS":      "HAS",
+	"IN":       "IN",
+	"CONTAINS": "CONTAINS",
+}
+
+func tokenizeExpression(expression string) ([]expressionToken, error) {
+	tokens := []expressionToken{}
+	runes := []rune(expression)
+
+	for index := 0; index < len(runes); {
+		character := runes[index]
+		position := index + 1
+
+		switch {
+		case unicode.IsSpace(character):
+			index++
+		case character == '(':
+			tokens = append(tokens, expressionToken{openParenthesisToken, "(", position})
+			index++
+		case character == ')':
+			tokens = append(tokens, expressionToken{closeParenthesisToken, ")", position})
+			index++
+		case character == '[':
+			tokens = append(tokens, expressionToken{openBracketToken, "[", position})
+			index++
+		case character == ']':
+			tokens = append(tokens, expressionToken{closeBracketToken, "]", position})
+			index++
+		case character == ',':
+			tokens = append(tokens, expressionToken{commaToken, ",", position})
+			index++
+		case character == '\'' || character == '"':
+			var builder strings.Builder
+			end := index + 1
+			for ; end < len(runes) && runes[end] != character; end++ {
+				if runes[end] == '\\' && end+1 < len(runes) {
+					end++
+				}
+				builder.WriteRune(runes[end])
+			}
+			if end >= len(runes) {
+				return nil, &ExpressionError{Message: "unterminated string", Position: position}
+			}
+			tokens = append(tokens, expressionToken{stringToken, builder.String(), position})
+			index = end + 1
+		case strings.ContainsRune("=!<>", character):
+			operator := string(character)
+			if index+1 < len(runes) && runes[index+1] == '=' {
+				operator += "="
+			}
+			if operator == "!" {
+				return nil, &ExpressionError{Message: `unexpected character "!"`, Position: position}
+			}
+			tokens = append(tokens, expressionToken{operatorToken, operator, position})
+			index += len(operator)
+		case character == '&' || character == '|':
+			if index+1 >= len(runes) || runes[index+1] != character {
+				return nil, &ExpressionError{Message: fmt.Sprintf("unexpected character %q", string(character)), Position: position}
+			}
+			operator := "AND"
+			if character == '|' {
+				operator = "OR"
+			}
+			tokens = append(tokens, expressionToken{identifierToken, operator, position})
+			index += 2
+		case unicode.IsDigit(character) || (character == '-' && index+1 < len(runes) && unicode.IsDigit(runes[index+1])):
+			end := index + 1
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || runes[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, expressionToken{numberToken, string(runes[index:end]), position})
+			index = end
+		case unicode.IsLetter(character) || character == '_':
+			end := index + 1
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || strings.ContainsRune("_.-", runes[end])) {
+				end++
+			}
+			tokens = append(tokens, expressionToken{identifierToken, string(runes[index:end]), position})
+			index = end
+		default:
+			return nil, &ExpressionError{Message: fmt.Sprintf("unexpected character %q", string(character)), Position: position}
+		}
+	}
+
+	return append(tokens, expressionToken{endToken, "", utf8.RuneCountInString(expression) + 1}), nil
+}
+
+type expressionParser struct {
+	tokens []expressionToken
+	index  int
+}
+
+// ParseExpression parses a TaskRouter expression, returning an ExpressionError when the expression is not valid
+func ParseExpression(expression string) (Expression, error) {
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &expressionParser{tokens: tokens}
+	if parser.peek().tokenType == endToken {
+		return nil, &ExpressionError{Message: "expression cannot be empty", Position: 1}
+	}
+
+	parsedExpression, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token.tokenType != endToken {
+		return nil, unexpectedTokenError(token, "AND, OR or end of expression")
+	}
+	return parsedExpression, nil
+}
+
+func (p *expressionParser) peek() expressionToken {
+	return p.tokens[p.index]
+}
+
+func (p *expressionParser) next() expressionToken {
+	token := p.tokens[p.index]
+	if token.tokenType != endToken {
+		p.index++
+	}
+	return token
+}
+
+func (p *expressionParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.tokenType == identifierToken && strings.EqualFold(token.value, keyword)
+}
+
+func (p *expressionParser) parseOr() (Expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = LogicalExpression{Operator: "OR", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *expressionParser) parseAnd() (Expression, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("AND") {
+		p.next()
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = LogicalExpression{Operator: "AND", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *expressionParser) parseTerm() (Expression, error) {
+	if p.peek().tokenType == openParenthesisToken {
+		p.next()
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if token := p.next(); token.tokenType != closeParenthesisToken {
+			return nil, unexpectedTokenError(token, `")"`)
+		}
+		return expression, nil
+	}
+
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	operator, ok := p.parseComparisonOperator()
+	if !ok {
+		if p.isEndOfTerm() {
+			return OperandExpression{Operand: left}, nil
+		}
+		return nil, unexpectedTokenError(p.peek(), "a comparison operator")
+	}
+
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return ComparisonExpression{Operator: operator, Left: left, Right: right}, nil
+}
+
+func (p *expressionParser) isEndOfTerm() bool {
+	token := p.peek()
+	return token.tokenType == endToken || token.tokenType == closeParenthesisToken || p.isKeyword("AND") || p.isKeyword("OR")
+}
+
+func (p *expressionParser) parseComparisonOperator() (string, bool) {
+	token := p.peek()
+	if token.tokenType != operatorToken && token.tokenType != identifierToken {
+		return "", false
+	}
+
+	if p.isKeyword("NOT") {
+		p.next()
+		if !p.isKeyword("IN") {
+			// Step back so the error is reported against the NOT keyword
+			p.index--
+			return "", false
+		}
+		p.next()
+		return "NOT IN", true
+	}
+
+	operator, ok := comparisonOperators[strings.ToUpper(token.value)]
+	if !ok {
+		return "", false
+	}
+	p.next()
+	return operator, true
+}
+
+func (p *expressionParser) parseOperand() (Operand, error) {
+	token := p.next()
+
+	switch token.tokenType {
+	case stringToken:
+		return LiteralOperand{Value: token.value}, nil
+	case numberToken:
+		value, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return nil, &ExpressionError{Message: fmt.Sprintf("invalid number %q", token.value), Position: token.position}
+		}
+		return LiteralOperand{Value: value}, nil
+	case openBracketToken:
+		values := []Operand{}
+		if p.peek().tokenType == closeBracketToken {
+			p.next()
+			return ListOperand{Values: values}, nil
+		}
+		for {
+			value, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := value.(ListOperand); ok {
+				return nil, &ExpressionError{Message: "lists cannot be nested", Position: token.position}
+			}
+			values = append(values, value)
+
+			separator := p.next()
+			if separator.tokenType == closeBracketToken {
+				return ListOperand{Values: values}, nil
+			}
+			if separator.tokenType != commaToken {
+				return nil, unexpectedTokenError(separator, `"," or "]"`)
+			}
+		}
+	case identifierToken:
+		switch strings.ToUpper(token.value) {
+		case "TRUE":
+			return LiteralOperand{Value: true}, nil
+		case "FALSE":
+			return LiteralOperand{Value: false}, nil
+		case "NULL":
+			return LiteralOperand{Value: nil}, nil
+		case "AND", "OR", "HAS", "IN", "NOT", "CONTAINS":
+			return nil, unexpectedTokenError(token, "an attribute or value")
+		}
+
+		path := strings.Split(token.value, ".")
+		for _, segment := range path {
+			if segment == "" {
+				return nil, &ExpressionError{Message: fmt.Sprintf("invalid attribute %q", token.value), Position: token.position}
+			}
+		}
+		return AttributeOperand{Path: path, Position: token.position}, nil
+	default:
+		return nil, unexpectedTokenError(token, "an attribute or value")
+	}
+}
+
+func unexpectedTokenError(token expressionToken, expected string) *ExpressionError {
+	return &ExpressionError{
+		Message:  fmt.Sprintf("unexpected %s, expected %s", token, expected),
+		Position: token.position,
+	}
+}
@@ -0,0 +1,153 @@
+package helper
+
+import (
+	"reflect"
+	"strings"
+)
+
+// EvaluateExpression evaluates the parsed TaskRouter expression against the attributes.
+// Attributes which cannot be found are treated as null, so comparisons against missing attributes do not match
+func EvaluateExpression(expression Expression, attributes map[string]interface{}) bool {
+	switch node := expression.(type) {
+	case LogicalExpression:
+		if node.Operator == "AND" {
+			return EvaluateExpression(node.Left, attributes) && EvaluateExpression(node.Right, attributes)
+		}
+		return EvaluateExpression(node.Left, attributes) || EvaluateExpression(node.Right, attributes)
+	case ComparisonExpression:
+		return compareOperands(node.Operator, ResolveOperand(node.Left, attributes), ResolveOperand(node.Right, attributes))
+	case OperandExpression:
+		value, ok := ResolveOperand(node.Operand, attributes).(bool)
+		return ok && value
+	}
+	return false
+}
+
+// ResolveOperand returns the value of the operand. Attribute values are looked up in the attributes using the dot separated path
+func ResolveOperand(operand Operand, attributes map[string]interface{}) interface{} {
+	switch node := operand.(type) {
+	case LiteralOperand:
+		return node.Value
+	case ListOperand:
+		values := []interface{}{}
+		for _, value := range node.Values {
+			values = append(values, ResolveOperand(value, attributes))
+		}
+		return values
+	case AttributeOperand:
+		var value interface{} = attributes
+		for _, segment := range node.Path {
+			valueMap, ok := value.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			if value, ok = valueMap[segment]; !ok {
+				return nil
+			}
+		}
+		return normaliseValue(value)
+	}
+	return nil
+}
+
+func normaliseValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case int:
+		return float64(typedValue)
+	case int64:
+		return float64(typedValue)
+	case []string:
+		values := []interface{}{}
+		for _, item := range typedValue {
+			values = append(values, item)
+		}
+		return values
+	}
+	return value
+}
+
+func compareOperands(operator string, left interface{}, right interface{}) bool {
+	switch operator {
+	case "==":
+		return left != nil && valuesEqual(left, right)
+	case "!=":
+		return left != nil && !valuesEqual(left, right)
+	case ">", ">=", "<", "<=":
+		return compareOrdered(operator, left, right)
+	case "HAS":
+		return listContains(left, right)
+	case "IN":
+		return listContains(right, left)
+	case "NOT IN":
+		return left != nil && !listContains(right, left)
+	case "CONTAINS":
+		leftString, leftOk := left.(string)
+		rightString, rightOk := right.(string)
+		return leftOk && rightOk && strings.Contains(leftString, rightString)
+	}
+	return false
+}
+
+func valuesEqual(left interface{}, right interface{}) bool {
+	return reflect.DeepEqual(normaliseValue(left), normaliseValue(right))
+}
+
+func compareOrdered(operator string, left interface{}, right interface{}) bool {
+	var comparison int
+	switch leftValue := left.(type) {
+	case float64:
+		rightValue, ok := right.(float64)
+		if !ok {
+			return false
+		}
+		switch {
+		case leftValue < rightValue:
+			comparison = -1
+		case leftValue > rightValue:
+			comparison = 1
+		}
+	case string:
+		rightValue, ok := right.(string)
+		if !ok {
+			return false
+		}
+		comparison = strings.Compare(leftValue, rightValue)
+	default:
+		return false
+	}
+
+	switch operator {
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	case "<":
+		return comparison < 0
+	default:
+		return comparison <= 0
+	}
+}
+
+// listContains checks whether the list contains the value. When the value is also a list, any shared item is treated as a match
+func listContains(list interface{}, value interface{}) bool {
+	items, ok := list.([]interface{})
+	if !ok {
+		return false
+	}
+
+	if values, ok := value.([]interface{}); ok {
+		for _, item := range values {
+			if listContains(items, item) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, item := range items {
+		if valuesEqual(item, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ExpressionContext determines which attributes can be referenced in a TaskRouter expression
+type ExpressionContext int
+
+const (
+	// TaskExpressionContext is used for workflow filter expressions, which reference task attributes
+	TaskExpressionContext ExpressionContext = iota
+	// TargetExpressionContext is used for workflow target expressions, which reference task attributes using the task. prefix and worker attributes using the worker. prefix
+	TargetExpressionContext
+	// WorkerExpressionContext is used for task queue target workers expressions, which reference worker attributes
+	WorkerExpressionContext
+)
+
+// ValidateExpression parses the TaskRouter expression and returns an error if the syntax is invalid.
+// Warnings are returned for any attributes which are not expected to be referenced in the expression context, as TaskRouter accepts these expressions
+func ValidateExpression(expression string, context ExpressionContext) ([]string, error) {
+	parsedExpression, err := ParseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	return expressionAttributeWarnings(parsedExpression, context), nil
+}
+
+func expressionAttributeWarnings(expression Expression, context ExpressionContext) []string {
+	switch node := expression.(type) {
+	case LogicalExpression:
+		return append(expressionAttributeWarnings(node.Left, context), expressionAttributeWarnings(node.Right, context)...)
+	case ComparisonExpression:
+		return append(operandAttributeWarnings(node.Left, context), operandAttributeWarnings(node.Right, context)...)
+	case OperandExpression:
+		return operandAttributeWarnings(node.Operand, context)
+	}
+	return nil
+}
+
+func operandAttributeWarnings(operand Operand, context ExpressionContext) []string {
+	warnings := []string{}
+
+	switch node := operand.(type) {
+	case ListOperand:
+		for _, value := range node.Values {
+			warnings = append(warnings, operandAttributeWarnings(value, context)...)
+		}
+	case AttributeOperand:
+		prefix := node.Path[0]
+		if strings.EqualFold(prefix, "taskrouter") {
+			return warnings
+		}
+
+		switch context {
+		case TargetExpressionContext:
+			if len(node.Path) < 2 || (prefix != "task" && prefix != "worker") {
+				warnings = append(warnings, (&ExpressionError{Message: fmt.Sprintf("attribute %q is not prefixed with task. or worker.", node.Name()), Position: node.Position}).Error())
+			}
+		case TaskExpressionContext:
+			if prefix == "worker" && len(node.Path) > 1 {
+				warnings = append(warnings, (&ExpressionError{Message: fmt.Sprintf("worker attribute %q is referenced in a workflow filter expression", node.Name()), Position: node.Position}).Error())
+			}
+		case WorkerExpressionContext:
+			if prefix == "task" && len(node.Path) > 1 {
+				warnings = append(warnings, (&ExpressionError{Message: fmt.Sprintf("task attribute %q is referenced in a target workers expression", node.Name()), Position: node.Position}).Error())
+			}
+		}
+	}
+	return warnings
+}
+
+// ExpressionValidation returns a SchemaValidateFunc which validates the syntax of a TaskRouter expression.
+// A warning is returned for each attribute which is not expected to be referenced in the expression context
+func ExpressionValidation(context ExpressionContext) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		value, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		expressionWarnings, err := ValidateExpression(value, context)
+		if err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be a valid TaskRouter expression, %s", k, err.Error())}
+		}
+
+		warnings := []string{}
+		for _, warning := range expressionWarnings {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", k, warning))
+		}
+		return warnings, nil
+	}
+}
+
+// WorkflowConfigurationValidation returns a SchemaValidateFunc which validates the syntax of the filter and target expressions in a JSON workflow configuration
+func WorkflowConfigurationValidation() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		value, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		configuration, err := ParseWorkflowConfiguration(value)
+		if err != nil {
+			return nil, []error{fmt.Errorf("%q contains an invalid workflow configuration: %s", k, err.Error())}
+		}
+
+		warnings := []string{}
+		errors := []error{}
+		for filterIndex, filter := range configuration.TaskRouting.Filters {
+			filterWarnings, err := ValidateExpression(filter.Expression, TaskExpressionContext)
+			if err != nil {
+				errors = append(errors, fmt.Errorf("expected the expression of filter %d in %s to be a valid TaskRouter expression, %s", filterIndex, k, err.Error()))
+			}
+			for _, warning := range filterWarnings {
+				warnings = append(warnings, fmt.Sprintf("the expression of filter %d in %s: %s", filterIndex, k, warning))
+			}
+
+			for targetIndex, target := range filter.Targets {
+				if target.Expression == nil {
+					continue
+				}
+				targetWarnings, err := ValidateExpression(*target.Expression, TargetExpressionContext)
+				if err != nil {
+					errors = append(errors, fmt.Errorf("expected the expression of target %d of filter %d in %s to be a valid TaskRouter expression, %s", targetIndex, filterIndex, k, err.Error()))
+				}
+				for _, warning := range targetWarnings {
+					warnings = append(warnings, fmt.Sprintf("the expression of target %d of filter %d in %s: %s", targetIndex, filterIndex, k, warning))
+				}
+			}
+		}
+		return warnings, errors
+	}
+}
+
+// ExpressionValidationAsWarnings returns a SchemaValidateFunc which reports syntax errors in a TaskRouter expression as warnings.
+// This is used for existing attributes, so expressions which Twilio accepts but the provider cannot parse do not fail the plan
+func ExpressionValidationAsWarnings(context ExpressionContext) schema.SchemaValidateFunc {
+	return validationErrorsAsWarnings(ExpressionValidation(context))
+}
+
+// WorkflowConfigurationValidationAsWarnings returns a SchemaValidateFunc which reports syntax errors in the filter and target expressions of a JSON workflow configuration as warnings.
+// This is used for existing attributes, so expressions which Twilio accepts but the provider cannot parse do not fail the plan
+func WorkflowConfigurationValidationAsWarnings() schema.SchemaValidateFunc {
+	return validationErrorsAsWarnings(WorkflowConfigurationValidation())
+}
+
+func validationErrorsAsWarnings(validateFunc schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		warnings, errors := validateFunc(i, k)
+		for _, err := range errors {
+			warnings = append(warnings, fmt.Sprintf("%s. The expression will be validated by Twilio when it is applied", err.Error()))
+		}
+		return warnings, nil
+	}
+}
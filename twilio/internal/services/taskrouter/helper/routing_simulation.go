@@ -0,0 +1,170 @@
+package helper
+
+import "fmt"
+
+// SimulationTaskQueue is the definition of a task queue used in a routing simulation
+type SimulationTaskQueue struct {
+	Sid           string
+	FriendlyName  string
+	TargetWorkers string
+}
+
+// SimulationTask is a sample task used in a routing simulation
+type SimulationTask struct {
+	Name       string
+	Attributes map[string]interface{}
+}
+
+// SimulationWorker is a sample worker used in a routing simulation
+type SimulationWorker struct {
+	Sid          string
+	FriendlyName string
+	Attributes   map[string]interface{}
+}
+
+// SimulationResult is the outcome of routing a sample task through the workflow configuration
+type SimulationResult struct {
+	TaskName           string
+	FilterFriendlyName string
+	DefaultFilter      bool
+	Targets            []SimulationTargetResult
+}
+
+// SimulationTargetResult contains the task queue and the eligible workers for a workflow target
+type SimulationTargetResult struct {
+	QueueSid          string
+	QueueFriendlyName string
+	EligibleWorkers   []string
+}
+
+// SimulateRouting evaluates which workflow filter each task matches and which workers are eligible for each target of the filter.
+// The filters are evaluated in order and the first matching filter is used, if no filters match the default filter is used.
+// Each worker is eligible for a target if the worker matches the target workers expression of the task queue and the target expression
+func SimulateRouting(configuration *WorkflowConfiguration, taskQueues []SimulationTaskQueue, tasks []SimulationTask, workers []SimulationWorker) ([]SimulationResult, error) {
+	queues := make(map[string]SimulationTaskQueue)
+	queueWorkers := make(map[string][]SimulationWorker)
+	for _, taskQueue := range taskQueues {
+		targetWorkers := taskQueue.TargetWorkers
+		if targetWorkers == "" {
+			targetWorkers = "1==1"
+		}
+
+		expression, err := ParseExpression(targetWorkers)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse the target workers expression of task queue (%s): %s", taskQueue.Sid, err.Error())
+		}
+
+		queues[taskQueue.Sid] = taskQueue
+		queueWorkers[taskQueue.Sid] = []SimulationWorker{}
+		for _, worker := range workers {
+			if EvaluateExpression(expression, worker.Attributes) {
+				queueWorkers[taskQueue.Sid] = append(queueWorkers[taskQueue.Sid], worker)
+			}
+		}
+	}
+
+	results := []SimulationResult{}
+	for _, task := range tasks {
+		result := SimulationResult{
+			TaskName: task.Name,
+			Targets:  []SimulationTargetResult{},
+		}
+
+		var matchedFilter *WorkflowFilter
+		for index, filter := range configuration.TaskRouting.Filters {
+			expression, err := ParseExpression(filter.Expression)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to parse the expression of filter %d: %s", index, err.Error())
+			}
+			if EvaluateExpression(expression, task.Attributes) {
+				matchedFilter = &configuration.TaskRouting.Filters[index]
+				break
+			}
+		}
+
+		if matchedFilter != nil {
+			result.FilterFriendlyName = matchedFilter.FilterFriendlyName
+			for index, target := range matchedFilter.Targets {
+				targetResult, err := simulateTarget(target, queues, queueWorkers, task)
+				if err != nil {
+					return nil, fmt.Errorf("Failed to simulate target %d of filter (%s): %s", index, matchedFilter.FilterFriendlyName, err.Error())
+				}
+				result.Targets = append(result.Targets, *targetResult)
+			}
+		} else if configuration.TaskRouting.DefaultFilter != nil {
+			result.DefaultFilter = true
+			targetResult, err := simulateTarget(WorkflowTarget{Queue: configuration.TaskRouting.DefaultFilter.Queue}, queues, queueWorkers, task)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to simulate the default filter: %s", err.Error())
+			}
+			result.Targets = append(result.Targets, *targetResult)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func simulateTarget(target WorkflowTarget, queues map[string]SimulationTaskQueue, queueWorkers map[string][]SimulationWorker, task SimulationTask) (*SimulationTargetResult, error) {
+	queue, ok := queues[target.Queue]
+	if !ok {
+		return nil, fmt.Errorf("The task queue (%s) has not been defined", target.Queue)
+	}
+
+	var targetExpression Expression
+	if target.Expression != nil {
+		expression, err := ParseExpression(*target.Expression)
+		if err != nil {
+			return nil, err
+		}
+		targetExpression = expression
+	}
+
+	var knownWorker Operand
+	knownWorkerExpression := target.KnownWorkerSid
+	if knownWorkerExpression == nil {
+		knownWorkerExpression = target.KnownWorkerFriendlyName
+	}
+	if knownWorkerExpression != nil {
+		expression, err := ParseExpression(*knownWorkerExpression)
+		if err != nil {
+			return nil, err
+		}
+		operandExpression, ok := expression.(OperandExpression)
+		if !ok {
+			return nil, fmt.Errorf("The known worker expression (%s) must be an attribute or value", *knownWorkerExpression)
+		}
+		knownWorker = operandExpression.Operand
+	}
+
+	eligibleWorkers := []string{}
+	for _, worker := range queueWorkers[target.Queue] {
+		attributes := map[string]interface{}{
+			"task":   task.Attributes,
+			"worker": worker.Attributes,
+		}
+
+		if targetExpression != nil && !EvaluateExpression(targetExpression, attributes) {
+			continue
+		}
+
+		if knownWorker != nil {
+			workerIdentifier := worker.Sid
+			if target.KnownWorkerSid == nil {
+				workerIdentifier = worker.FriendlyName
+			}
+			if !valuesEqual(ResolveOperand(knownWorker, attributes), workerIdentifier) {
+				continue
+			}
+		}
+
+		eligibleWorkers = append(eligibleWorkers, worker.FriendlyName)
+	}
+
+	return &SimulationTargetResult{
+		QueueSid:          queue.Sid,
+		QueueFriendlyName: queue.FriendlyName,
+		EligibleWorkers:   eligibleWorkers,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package helper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func OptionalStatisticsTime(d *schema.ResourceData, key string) *time.Time {
+	if v, ok := d.GetOk(key); ok {
+		// The value has already been validated as RFC3339 by the schema
+		parsedTime, _ := time.Parse(time.RFC3339, v.(string))
+		return &parsedTime
+	}
+	return nil
+}
+
+func OptionalSplitByWaitTime(d *schema.ResourceData) *string {
+	if v, ok := d.GetOk("split_by_wait_time"); ok {
+		waitTimes := []string{}
+		for _, waitTime := range v.([]interface{}) {
+			waitTimes = append(waitTimes, strconv.Itoa(waitTime.(int)))
+		}
+		return sdkUtils.String(strings.Join(waitTimes, ","))
+	}
+	return nil
+}
+
+func FlattenStatisticsBreakdown(avg int, max int, min int, total int) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"avg":   avg,
+			"max":   max,
+			"min":   min,
+			"total": total,
+		},
+	}
+}
+
+func FlattenActivityStatistic(sid string, friendlyName string, workers int) map[string]interface{} {
+	return map[string]interface{}{
+		"sid":           sid,
+		"friendly_name": friendlyName,
+		"workers":       workers,
+	}
+}
+
+func FlattenActivityDuration(sid string, friendlyName string, avg int, max int, min int, total int) map[string]interface{} {
+	return map[string]interface{}{
+		"sid":           sid,
+		"friendly_name": friendlyName,
+		"avg":           avg,
+		"max":           max,
+		"min":           min,
+		"total":         total,
+	}
+}
+
+// FlattenWaitTime converts the wait time key returned by Twilio into a number so the split by wait time blocks can be sorted
+func FlattenWaitTime(waitTime string, aboveReservationsAccepted int, aboveTasksCanceled int, belowReservationsAccepted int, belowTasksCanceled int) map[string]interface{} {
+	waitTimeSeconds, _ := strconv.Atoi(strings.TrimSpace(waitTime))
+
+	return map[string]interface{}{
+		"wait_time": waitTimeSeconds,
+		"above": []interface{}{
+			map[string]interface{}{
+				"reservations_accepted": aboveReservationsAccepted,
+				"tasks_canceled":        aboveTasksCanceled,
+			},
+		},
+		"below": []interface{}{
+			map[string]interface{}{
+				"reservations_accepted": belowReservationsAccepted,
+				"tasks_canceled":        belowTasksCanceled,
+			},
+		},
+	}
+}
+
+// SortWaitTimes sorts the split by wait time blocks in ascending wait time order as Twilio returns the wait times as an unordered map
+func SortWaitTimes(waitTimes []interface{}) []interface{} {
+	sort.Slice(waitTimes, func(i, j int) bool {
+		return waitTimes[i].(map[string]interface{})["wait_time"].(int) < waitTimes[j].(map[string]interface{})["wait_time"].(int)
+	})
+	return waitTimes
+}
+
+func FlattenIntMap(input map[string]int) map[string]interface{} {
+	result := make(map[string]interface{})
+	for key, value := range input {
+		result[key] = value
+	}
+	return result
+}
+
+func FlattenOptionalInt(input *int) int {
+	if input == nil {
+		return 0
+	}
+	return *input
+}
+
+func FlattenOptionalString(input *string) string {
+	if input == nil {
+		return ""
+	}
+	return *input
+}
@@ -0,0 +1,185 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+)
+
+// TopologyActivity is an activity which exists in the workspace
+type TopologyActivity struct {
+	Sid          string
+	FriendlyName string
+	Available    bool
+}
+
+// TopologyWorker is a worker which exists in the workspace
+type TopologyWorker struct {
+	Sid          string
+	FriendlyName string
+	ActivitySid  string
+	Attributes   map[string]interface{}
+}
+
+// TopologyTaskQueue is a task queue which exists in the workspace and the workers which match the target workers expression of the task queue.
+// TargetWorkersError is populated when the target workers expression cannot be parsed
+type TopologyTaskQueue struct {
+	Sid                string
+	FriendlyName       string
+	TargetWorkers      string
+	TargetWorkersError string
+	WorkerSids         []string
+}
+
+// TopologyWorkflow is a workflow which exists in the workspace.
+// ConfigurationError is populated when the configuration cannot be parsed, in which case the configuration is nil and the filters of the workflow are not included in the topology
+type TopologyWorkflow struct {
+	Sid                string
+	FriendlyName       string
+	Configuration      *WorkflowConfiguration
+	ConfigurationError string
+}
+
+// Topology is the graph of workflows, filters, task queues, workers and activities in a workspace
+type Topology struct {
+	Activities []TopologyActivity
+	Workers    []TopologyWorker
+	TaskQueues []TopologyTaskQueue
+	Workflows  []TopologyWorkflow
+}
+
+// BuildTopology assembles the topology of the workspace by evaluating the target workers expression of each task queue against the attributes of each worker.
+// The target expressions of the workflow filters are not evaluated as they depend on the attributes of a task. When the target workers expression of a task queue
+// cannot be parsed, the error is recorded on the task queue and no workers are matched to it
+func BuildTopology(activities []TopologyActivity, taskQueues []TopologyTaskQueue, workers []TopologyWorker, workflows []TopologyWorkflow) *Topology {
+	topologyTaskQueues := []TopologyTaskQueue{}
+	for _, taskQueue := range taskQueues {
+		targetWorkers := taskQueue.TargetWorkers
+		if targetWorkers == "" {
+			targetWorkers = "1==1"
+		}
+
+		taskQueue.WorkerSids = []string{}
+
+		expression, err := ParseExpression(targetWorkers)
+		if err != nil {
+			taskQueue.TargetWorkersError = err.Error()
+			topologyTaskQueues = append(topologyTaskQueues, taskQueue)
+			continue
+		}
+
+		for _, worker := range workers {
+			if EvaluateExpression(expression, worker.Attributes) {
+				taskQueue.WorkerSids = append(taskQueue.WorkerSids, worker.Sid)
+			}
+		}
+		topologyTaskQueues = append(topologyTaskQueues, taskQueue)
+	}
+
+	return &Topology{
+		Activities: activities,
+		Workers:    workers,
+		TaskQueues: topologyTaskQueues,
+		Workflows:  workflows,
+	}
+}
+
+// graph converts the topology into a graph of workflows, filters, task queues, workers and activities
+func (topology Topology) graph() utils.Graph {
+	nodes := []utils.GraphNode{}
+	edges := []utils.GraphEdge{}
+	knownNodes := make(map[string]bool)
+
+	addNode := func(node utils.GraphNode) {
+		if !knownNodes[node.ID] {
+			knownNodes[node.ID] = true
+			nodes = append(nodes, node)
+		}
+	}
+
+	taskQueueNames := make(map[string]string)
+	for _, taskQueue := range topology.TaskQueues {
+		taskQueueNames[taskQueue.Sid] = taskQueue.FriendlyName
+	}
+	addTaskQueueNode := func(sid string) {
+		friendlyName, ok := taskQueueNames[sid]
+		if !ok {
+			friendlyName = sid
+		}
+		addNode(utils.GraphNode{ID: sid, Label: "Task Queue: " + friendlyName, Shape: utils.GraphShapeCylinder})
+	}
+
+	for _, workflow := range topology.Workflows {
+		addNode(utils.GraphNode{ID: workflow.Sid, Label: "Workflow: " + workflow.FriendlyName, Shape: utils.GraphShapeBox})
+		if workflow.Configuration == nil {
+			continue
+		}
+
+		for index, filter := range workflow.Configuration.TaskRouting.Filters {
+			filterID := fmt.Sprintf("%s_filter_%d", workflow.Sid, index)
+			addNode(utils.GraphNode{ID: filterID, Label: "Filter: " + filter.FilterFriendlyName, Shape: utils.GraphShapeDiamond})
+			edges = append(edges, utils.GraphEdge{From: workflow.Sid, To: filterID, Label: filter.Expression})
+
+			for _, target := range filter.Targets {
+				addTaskQueueNode(target.Queue)
+
+				label := ""
+				if target.Expression != nil {
+					label = *target.Expression
+				}
+				edges = append(edges, utils.GraphEdge{From: filterID, To: target.Queue, Label: label})
+			}
+		}
+
+		if workflow.Configuration.TaskRouting.DefaultFilter != nil {
+			defaultFilterID := fmt.Sprintf("%s_default_filter", workflow.Sid)
+			addNode(utils.GraphNode{ID: defaultFilterID, Label: "Default Filter", Shape: utils.GraphShapeDiamond})
+			edges = append(edges, utils.GraphEdge{From: workflow.Sid, To: defaultFilterID})
+
+			addTaskQueueNode(workflow.Configuration.TaskRouting.DefaultFilter.Queue)
+			edges = append(edges, utils.GraphEdge{From: defaultFilterID, To: workflow.Configuration.TaskRouting.DefaultFilter.Queue})
+		}
+	}
+
+	workerNames := make(map[string]string)
+	for _, worker := range topology.Workers {
+		workerNames[worker.Sid] = worker.FriendlyName
+	}
+
+	for _, taskQueue := range topology.TaskQueues {
+		addTaskQueueNode(taskQueue.Sid)
+		for _, workerSid := range taskQueue.WorkerSids {
+			addNode(utils.GraphNode{ID: workerSid, Label: "Worker: " + workerNames[workerSid], Shape: utils.GraphShapeRounded})
+			edges = append(edges, utils.GraphEdge{From: taskQueue.Sid, To: workerSid})
+		}
+	}
+
+	activityNames := make(map[string]string)
+	for _, activity := range topology.Activities {
+		activityNames[activity.Sid] = activity.FriendlyName
+	}
+
+	for _, worker := range topology.Workers {
+		addNode(utils.GraphNode{ID: worker.Sid, Label: "Worker: " + worker.FriendlyName, Shape: utils.GraphShapeRounded})
+		if worker.ActivitySid != "" {
+			addNode(utils.GraphNode{ID: worker.ActivitySid, Label: "Activity: " + activityNames[worker.ActivitySid], Shape: utils.GraphShapeStadium})
+			edges = append(edges, utils.GraphEdge{From: worker.Sid, To: worker.ActivitySid, Dashed: true})
+		}
+	}
+
+	return utils.Graph{
+		Direction: "LR",
+		Nodes:     nodes,
+		Edges:     edges,
+	}
+}
+
+// Mermaid renders the topology as a Mermaid flowchart
+func (topology Topology) Mermaid() string {
+	return topology.graph().Mermaid()
+}
+
+// DOT renders the topology as a Graphviz DOT digraph
+func (topology Topology) DOT() string {
+	return topology.graph().DOT()
+}
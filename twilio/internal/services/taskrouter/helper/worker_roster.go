@@ -0,0 +1,155 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// RosterWorker is a worker which currently exists in the workspace
+type RosterWorker struct {
+	Sid          string
+	FriendlyName string
+	ActivitySid  string
+	Attributes   string
+}
+
+// RosterWorkerConfiguration is the desired configuration of a worker in the roster. When the activity sid is nil the activity of the worker is not managed
+type RosterWorkerConfiguration struct {
+	FriendlyName string
+	ActivitySid  *string
+	Attributes   string
+}
+
+// WorkerRosterPlan contains the changes which are required to reconcile the workspace with the roster
+type WorkerRosterPlan struct {
+	Create []RosterWorkerConfiguration
+	Update []RosterWorkerUpdate
+	Delete []RosterWorker
+}
+
+// RosterWorkerUpdate is an existing worker and the configuration it should be updated to
+type RosterWorkerUpdate struct {
+	Sid           string
+	Configuration RosterWorkerConfiguration
+}
+
+// PlanWorkerRoster compares the workers which exist in the workspace with the desired roster and returns the creates, updates and deletes needed to reconcile them.
+// Only workers whose sid is in the managed sids are updated or deleted, unless adopt is true, in which case every worker in the workspace is treated as managed.
+// An error is returned when a worker in the roster has the same friendly name as an unmanaged worker and adopt is false
+func PlanWorkerRoster(existing []RosterWorker, managedSids map[string]bool, desired map[string]RosterWorkerConfiguration, adopt bool) (*WorkerRosterPlan, error) {
+	plan := &WorkerRosterPlan{
+		Create: []RosterWorkerConfiguration{},
+		Update: []RosterWorkerUpdate{},
+		Delete: []RosterWorker{},
+	}
+
+	existingWorkers := make(map[string]RosterWorker)
+	for _, worker := range existing {
+		existingWorkers[worker.FriendlyName] = worker
+	}
+
+	for _, friendlyName := range sortedKeys(desired) {
+		configuration := desired[friendlyName]
+		worker, ok := existingWorkers[friendlyName]
+		if !ok {
+			plan.Create = append(plan.Create, configuration)
+			continue
+		}
+
+		if !adopt && !managedSids[worker.Sid] {
+			return nil, fmt.Errorf("The worker (%s) already exists in the workspace with sid (%s) and is not managed by the roster. Set unmanaged_workers to adopt to manage the worker", friendlyName, worker.Sid)
+		}
+
+		if !rosterWorkerMatches(worker, configuration) {
+			plan.Update = append(plan.Update, RosterWorkerUpdate{
+				Sid:           worker.Sid,
+				Configuration: configuration,
+			})
+		}
+	}
+
+	for _, worker := range existing {
+		if _, ok := desired[worker.FriendlyName]; ok {
+			continue
+		}
+		if adopt || managedSids[worker.Sid] {
+			plan.Delete = append(plan.Delete, worker)
+		}
+	}
+	sort.Slice(plan.Delete, func(i, j int) bool {
+		return plan.Delete[i].FriendlyName < plan.Delete[j].FriendlyName
+	})
+
+	return plan, nil
+}
+
+func rosterWorkerMatches(worker RosterWorker, configuration RosterWorkerConfiguration) bool {
+	if configuration.ActivitySid != nil && *configuration.ActivitySid != worker.ActivitySid {
+		return false
+	}
+	return JSONEqual(worker.Attributes, configuration.Attributes)
+}
+
+// JSONEqual checks whether the two JSON strings are semantically equal. Strings which are not valid JSON are compared as is
+func JSONEqual(left string, right string) bool {
+	var leftValue, rightValue interface{}
+	if err := json.Unmarshal([]byte(left), &leftValue); err != nil {
+		return left == right
+	}
+	if err := json.Unmarshal([]byte(right), &rightValue); err != nil {
+		return left == right
+	}
+	return reflect.DeepEqual(leftValue, rightValue)
+}
+
+func sortedKeys(desired map[string]RosterWorkerConfiguration) []string {
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RunConcurrently calls the operation for each index from 0 to count - 1, with at most maxConcurrency operations running at once.
+// All operations are attempted and the errors are returned in index order
+func RunConcurrently(ctx context.Context, count int, maxConcurrency int, operation func(ctx context.Context, index int) error) []error {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	results := make([]error, count)
+	semaphore := make(chan struct{}, maxConcurrency)
+	var waitGroup sync.WaitGroup
+
+	for index := 0; index < count; index++ {
+		semaphore <- struct{}{}
+		waitGroup.Add(1)
+
+		go func(index int) {
+			defer func() {
+				<-semaphore
+				waitGroup.Done()
+			}()
+
+			if err := ctx.Err(); err != nil {
+				results[index] = err
+				return
+			}
+			results[index] = operation(ctx, index)
+		}(index)
+	}
+	waitGroup.Wait()
+
+	errors := []error{}
+	for _, err := range results {
+		if err != nil {
+			errors = append(errors, err)
+		}
+	}
+	return errors
+}
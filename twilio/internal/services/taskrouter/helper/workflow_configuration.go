@@ -0,0 +1,53 @@
+package helper
+
+import "encoding/json"
+
+// WorkflowConfiguration represents the JSON configuration of a TaskRouter workflow.
+// See https://www.twilio.com/docs/taskrouter/workflow-configuration for more information
+type WorkflowConfiguration struct {
+	TaskRouting WorkflowTaskRouting `json:"task_routing"`
+}
+
+type WorkflowTaskRouting struct {
+	Filters       []WorkflowFilter       `json:"filters"`
+	DefaultFilter *WorkflowDefaultFilter `json:"default_filter,omitempty"`
+}
+
+type WorkflowFilter struct {
+	FilterFriendlyName string           `json:"filter_friendly_name"`
+	Expression         string           `json:"expression"`
+	Targets            []WorkflowTarget `json:"targets"`
+}
+
+type WorkflowTarget struct {
+	Queue                   string  `json:"queue"`
+	Expression              *string `json:"expression,omitempty"`
+	Priority                *int    `json:"priority,omitempty"`
+	Timeout                 *int    `json:"timeout,omitempty"`
+	OrderBy                 *string `json:"order_by,omitempty"`
+	SkipIf                  *string `json:"skip_if,omitempty"`
+	KnownWorkerSid          *string `json:"known_worker_sid,omitempty"`
+	KnownWorkerFriendlyName *string `json:"known_worker_friendly_name,omitempty"`
+}
+
+type WorkflowDefaultFilter struct {
+	Queue string `json:"queue"`
+}
+
+// ParseWorkflowConfiguration unmarshals the JSON workflow configuration
+func ParseWorkflowConfiguration(configuration string) (*WorkflowConfiguration, error) {
+	workflowConfiguration := &WorkflowConfiguration{}
+	if err := json.Unmarshal([]byte(configuration), workflowConfiguration); err != nil {
+		return nil, err
+	}
+	return workflowConfiguration, nil
+}
+
+// ToString marshals the workflow configuration to a JSON string
+func (configuration WorkflowConfiguration) ToString() (string, error) {
+	jsonBytes, err := json.Marshal(configuration)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
@@ -12,18 +12,25 @@ func (r Registration) Name() string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"twilio_taskrouter_activities":    dataSourceTaskRouterActivities(),
-		"twilio_taskrouter_activity":      dataSourceTaskRouterActivity(),
-		"twilio_taskrouter_task_channel":  dataSourceTaskRouterTaskChannel(),
-		"twilio_taskrouter_task_channels": dataSourceTaskRouterTaskChannels(),
-		"twilio_taskrouter_task_queue":    dataSourceTaskRouterTaskQueue(),
-		"twilio_taskrouter_task_queues":   dataSourceTaskRouterTaskQueues(),
-		"twilio_taskrouter_worker":        dataSourceTaskRouterWorker(),
-		"twilio_taskrouter_workers":       dataSourceTaskRouterWorkers(),
-		"twilio_taskrouter_workflow":      dataSourceTaskRouterWorkflow(),
-		"twilio_taskrouter_workflows":     dataSourceTaskRouterWorkflows(),
-		"twilio_taskrouter_workspace":     dataSourceTaskRouterWorkspace(),
-		"twilio_taskrouter_workspaces":    dataSourceTaskRouterWorkspaces(),
+		"twilio_taskrouter_activities":             dataSourceTaskRouterActivities(),
+		"twilio_taskrouter_activity":               dataSourceTaskRouterActivity(),
+		"twilio_taskrouter_routing_simulation":     dataSourceTaskRouterRoutingSimulation(),
+		"twilio_taskrouter_task_channel":           dataSourceTaskRouterTaskChannel(),
+		"twilio_taskrouter_task_channels":          dataSourceTaskRouterTaskChannels(),
+		"twilio_taskrouter_task_queue":             dataSourceTaskRouterTaskQueue(),
+		"twilio_taskrouter_task_queue_statistics":  dataSourceTaskRouterTaskQueueStatistics(),
+		"twilio_taskrouter_task_queues":            dataSourceTaskRouterTaskQueues(),
+		"twilio_taskrouter_topology":               dataSourceTaskRouterTopology(),
+		"twilio_taskrouter_worker":                 dataSourceTaskRouterWorker(),
+		"twilio_taskrouter_worker_statistics":      dataSourceTaskRouterWorkerStatistics(),
+		"twilio_taskrouter_workers":                dataSourceTaskRouterWorkers(),
+		"twilio_taskrouter_workflow":               dataSourceTaskRouterWorkflow(),
+		"twilio_taskrouter_workflow_configuration": dataSourceTaskRouterWorkflowConfiguration(),
+		"twilio_taskrouter_workflow_statistics":    dataSourceTaskRouterWorkflowStatistics(),
+		"twilio_taskrouter_workflows":              dataSourceTaskRouterWorkflows(),
+		"twilio_taskrouter_workspace":              dataSourceTaskRouterWorkspace(),
+		"twilio_taskrouter_workspace_statistics":   dataSourceTaskRouterWorkspaceStatistics(),
+		"twilio_taskrouter_workspaces":             dataSourceTaskRouterWorkspaces(),
 	}
 }
 
@@ -34,6 +41,8 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"twilio_taskrouter_task_channel":            resourceTaskRouterTaskChannel(),
 		"twilio_taskrouter_task_queue":              resourceTaskRouterTaskQueue(),
 		"twilio_taskrouter_worker":                  resourceTaskRouterWorker(),
+		"twilio_taskrouter_worker_channel":          resourceTaskRouterWorkerChannel(),
+		"twilio_taskrouter_worker_roster":           resourceTaskRouterWorkerRoster(),
 		"twilio_taskrouter_workflow":                resourceTaskRouterWorkflow(),
 		"twilio_taskrouter_workspace":               resourceTaskRouterWorkspace(),
 		"twilio_taskrouter_workspace_configuration": resourceTaskRouterWorkspaceConfiguration(),
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
 	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
 	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/task_queue"
 	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/task_queues"
@@ -91,9 +92,10 @@ func resourceTaskRouterTaskQueue() *schema.Resource {
 				ValidateFunc: validation.IntBetween(1, 50),
 			},
 			"target_workers": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "1==1",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1==1",
+				ValidateFunc: helper.ExpressionValidationAsWarnings(helper.WorkerExpressionContext),
 			},
 			"task_order": {
 				Type:     schema.TypeString,
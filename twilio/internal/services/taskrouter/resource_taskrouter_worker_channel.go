@@ -0,0 +1,198 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/worker/channel"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTaskRouterWorkerChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTaskRouterWorkerChannelCreate,
+		ReadContext:   resourceTaskRouterWorkerChannelRead,
+		UpdateContext: resourceTaskRouterWorkerChannelUpdate,
+		DeleteContext: resourceTaskRouterWorkerChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				format := "/Workspaces/(.*)/Workers/(.*)/Channels/(.*)"
+				regex := regexp.MustCompile(format)
+				match := regex.FindStringSubmatch(d.Id())
+
+				if len(match) != 4 {
+					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
+				}
+
+				d.Set("workspace_sid", match[1])
+				d.Set("worker_sid", match[2])
+				d.Set("task_channel", match[3])
+				// The ID is replaced with the worker channel SID when the worker channel is read
+				d.SetId(match[3])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.TaskRouterWorkspaceSidValidation(),
+			},
+			"worker_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.TaskRouterWorkerSidValidation(),
+			},
+			"task_channel": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"available": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"task_channel_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_channel_unique_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assigned_tasks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_capacity_percentage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTaskRouterWorkerChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).TaskRouter
+
+	updateInput := &channel.UpdateChannelInput{
+		Capacity:  utils.OptionalInt(d, "capacity"),
+		Available: utils.OptionalBool(d, "available"),
+	}
+
+	// A capacity of 0 is not returned by GetOk, so the config is checked to determine whether the capacity has been set
+	if capacity := d.GetRawConfig().GetAttr("capacity"); updateInput.Capacity == nil && !capacity.IsNull() {
+		updateInput.Capacity = sdkUtils.Int(0)
+	}
+
+	// TaskRouter worker channels are created for each task channel when the worker is created, so updating the channel
+	updateResult, err := client.Workspace(d.Get("workspace_sid").(string)).Worker(d.Get("worker_sid").(string)).Channel(d.Get("task_channel").(string)).UpdateWithContext(ctx, updateInput)
+	if err != nil {
+		return diag.Errorf("Failed to update taskrouter worker channel: %s", err.Error())
+	}
+
+	d.SetId(updateResult.Sid)
+	return resourceTaskRouterWorkerChannelRead(ctx, d, meta)
+}
+
+func resourceTaskRouterWorkerChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).TaskRouter
+
+	getResponse, err := client.Workspace(d.Get("workspace_sid").(string)).Worker(d.Get("worker_sid").(string)).Channel(d.Get("task_channel").(string)).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read taskrouter worker channel: %s", err.Error())
+	}
+
+	d.SetId(getResponse.Sid)
+	d.Set("sid", getResponse.Sid)
+	d.Set("account_sid", getResponse.AccountSid)
+	d.Set("workspace_sid", getResponse.WorkspaceSid)
+	d.Set("worker_sid", getResponse.WorkerSid)
+	d.Set("capacity", getResponse.ConfiguredCapacity)
+	d.Set("available", getResponse.Available)
+	d.Set("task_channel_sid", getResponse.TaskChannelSid)
+	d.Set("task_channel_unique_name", getResponse.TaskChannelUniqueName)
+	d.Set("assigned_tasks", getResponse.AssignedTasks)
+	d.Set("available_capacity_percentage", getResponse.AvailableCapacityPercentage)
+	d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
+
+	if getResponse.DateUpdated != nil {
+		d.Set("date_updated", getResponse.DateUpdated.Format(time.RFC3339))
+	}
+
+	d.Set("url", getResponse.URL)
+
+	return nil
+}
+
+func resourceTaskRouterWorkerChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).TaskRouter
+
+	updateInput := &channel.UpdateChannelInput{
+		Capacity:  utils.OptionalIntWith0OnChange(d, "capacity"),
+		Available: utils.OptionalBool(d, "available"),
+	}
+
+	if _, err := client.Workspace(d.Get("workspace_sid").(string)).Worker(d.Get("worker_sid").(string)).Channel(d.Get("task_channel").(string)).UpdateWithContext(ctx, updateInput); err != nil {
+		return diag.Errorf("Failed to update taskrouter worker channel: %s", err.Error())
+	}
+
+	return resourceTaskRouterWorkerChannelRead(ctx, d, meta)
+}
+
+func resourceTaskRouterWorkerChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] TaskRouter worker channel cannot be deleted, so removing from the Terraform state")
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,372 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	taskrouter "github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1"
+	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/worker"
+	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/workers"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTaskRouterWorkerRoster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTaskRouterWorkerRosterCreate,
+		ReadContext:   resourceTaskRouterWorkerRosterRead,
+		UpdateContext: resourceTaskRouterWorkerRosterUpdate,
+		DeleteContext: resourceTaskRouterWorkerRosterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				format := "/Workspaces/(.*)/WorkerRoster(?:/Workers/(.*))?"
+				regex := regexp.MustCompile(format)
+				match := regex.FindStringSubmatch(d.Id())
+
+				if len(match) != 3 {
+					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
+				}
+
+				// Only the workers listed in the imported ID are managed by the roster, so importing the roster never causes other workers in the workspace to be deleted.
+				// The worker sids are keyed by sid until the friendly names are retrieved when the roster is read
+				workerSids := make(map[string]interface{})
+				if match[2] != "" {
+					for _, workerSid := range strings.Split(match[2], ",") {
+						if _, errs := utils.TaskRouterWorkerSidValidation()(workerSid, "worker_sids"); len(errs) > 0 {
+							return nil, fmt.Errorf("The imported ID (%s) does not contain a valid worker SID: %s", d.Id(), errs[0].Error())
+						}
+						workerSids[workerSid] = workerSid
+					}
+				}
+
+				d.Set("workspace_sid", match[1])
+				d.Set("unmanaged_workers", "ignore")
+				d.Set("max_concurrency", 10)
+				d.Set("worker_sids", workerSids)
+				d.SetId(match[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.TaskRouterWorkspaceSidValidation(),
+			},
+			"workers": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateDiagFunc: validateWorkerRosterAttributes,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"activity_sids": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateDiagFunc: validation.MapValueMatch(regexp.MustCompile("^WA[0-9a-fA-F]{32}$"), "Map value expected to be a taskrouter activity sid"),
+			},
+			"unmanaged_workers": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ignore",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ignore",
+					"adopt",
+				}, false),
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"worker_sids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+
+		CustomizeDiff: customdiff.All(
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.NewValueKnown("workers") || !d.NewValueKnown("activity_sids") {
+					return nil
+				}
+
+				workers := d.Get("workers").(map[string]interface{})
+				for friendlyName := range d.Get("activity_sids").(map[string]interface{}) {
+					if _, ok := workers[friendlyName]; !ok {
+						return fmt.Errorf("The activity sid for worker (%s) has been specified but the worker is not in the roster", friendlyName)
+					}
+				}
+				return nil
+			},
+			customdiff.ComputedIf("worker_sids", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("workers") || d.HasChange("unmanaged_workers")
+			}),
+		),
+	}
+}
+
+func validateWorkerRosterAttributes(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for friendlyName, attributes := range v.(map[string]interface{}) {
+		if strings.TrimSpace(friendlyName) == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid worker friendly name",
+				Detail:        "The friendly name of a worker cannot be empty",
+				AttributePath: append(path, cty.IndexStep{Key: cty.StringVal(friendlyName)}),
+			})
+		}
+
+		if _, errs := validation.StringIsJSON(attributes, friendlyName); len(errs) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid worker attributes",
+				Detail:        fmt.Sprintf("The attributes of worker (%s) must be a JSON string: %s", friendlyName, errs[0].Error()),
+				AttributePath: append(path, cty.IndexStep{Key: cty.StringVal(friendlyName)}),
+			})
+		}
+	}
+	return diags
+}
+
+func resourceTaskRouterWorkerRosterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("workspace_sid").(string))
+
+	diags := reconcileTaskRouterWorkerRoster(ctx, d, meta)
+	return append(diags, resourceTaskRouterWorkerRosterRead(ctx, d, meta)...)
+}
+
+func resourceTaskRouterWorkerRosterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).TaskRouter
+
+	existingWorkers, err := listTaskRouterRosterWorkers(ctx, client, d.Id())
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read taskrouter worker roster: %s", err.Error())
+	}
+
+	adopt := d.Get("unmanaged_workers").(string) == "adopt"
+	managedSids := managedTaskRouterRosterWorkerSids(d.Get("worker_sids").(map[string]interface{}))
+	configuredActivitySids := d.Get("activity_sids").(map[string]interface{})
+
+	rosterWorkers := make(map[string]interface{})
+	activitySids := make(map[string]interface{})
+	workerSids := make(map[string]interface{})
+
+	for _, existingWorker := range existingWorkers {
+		if !adopt && !managedSids[existingWorker.Sid] {
+			continue
+		}
+
+		rosterWorkers[existingWorker.FriendlyName] = existingWorker.Attributes
+		workerSids[existingWorker.FriendlyName] = existingWorker.Sid
+		if _, ok := configuredActivitySids[existingWorker.FriendlyName]; ok {
+			activitySids[existingWorker.FriendlyName] = existingWorker.ActivitySid
+		}
+	}
+
+	d.Set("workspace_sid", d.Id())
+	d.Set("workers", rosterWorkers)
+	d.Set("activity_sids", activitySids)
+	d.Set("worker_sids", workerSids)
+
+	return nil
+}
+
+func resourceTaskRouterWorkerRosterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := reconcileTaskRouterWorkerRoster(ctx, d, meta)
+	return append(diags, resourceTaskRouterWorkerRosterRead(ctx, d, meta)...)
+}
+
+func resourceTaskRouterWorkerRosterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).TaskRouter
+
+	workerSids := []string{}
+	for _, workerSid := range d.Get("worker_sids").(map[string]interface{}) {
+		workerSids = append(workerSids, workerSid.(string))
+	}
+
+	errors := helper.RunConcurrently(ctx, len(workerSids), d.Get("max_concurrency").(int), func(ctx context.Context, index int) error {
+		if err := client.Workspace(d.Id()).Worker(workerSids[index]).DeleteWithContext(ctx); err != nil && !utils.IsNotFoundError(err) {
+			return fmt.Errorf("Failed to delete taskrouter worker (%s): %s", workerSids[index], err.Error())
+		}
+		return nil
+	})
+	if len(errors) > 0 {
+		return errorsToDiagnostics(errors)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func reconcileTaskRouterWorkerRoster(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).TaskRouter
+	workspaceSid := d.Id()
+
+	existingWorkers, err := listTaskRouterRosterWorkers(ctx, client, workspaceSid)
+	if err != nil {
+		return diag.Errorf("Failed to list taskrouter workers: %s", err.Error())
+	}
+
+	// The new value of worker_sids is unknown when the roster changes, so the previous value is used to determine which workers are managed
+	previousWorkerSids, _ := d.GetChange("worker_sids")
+	managedSids := managedTaskRouterRosterWorkerSids(previousWorkerSids.(map[string]interface{}))
+	adopt := d.Get("unmanaged_workers").(string) == "adopt"
+
+	activitySids := d.Get("activity_sids").(map[string]interface{})
+	desired := make(map[string]helper.RosterWorkerConfiguration)
+	for friendlyName, attributes := range d.Get("workers").(map[string]interface{}) {
+		configuration := helper.RosterWorkerConfiguration{
+			FriendlyName: friendlyName,
+			Attributes:   attributes.(string),
+		}
+		if activitySid, ok := activitySids[friendlyName]; ok {
+			configuration.ActivitySid = sdkUtils.String(activitySid.(string))
+		}
+		desired[friendlyName] = configuration
+	}
+
+	plan, err := helper.PlanWorkerRoster(existingWorkers, managedSids, desired, adopt)
+	if err != nil {
+		return diag.Errorf("Failed to reconcile taskrouter worker roster: %s", err.Error())
+	}
+
+	var mutex sync.Mutex
+	workerSids := make(map[string]interface{})
+	for _, existingWorker := range existingWorkers {
+		if adopt || managedSids[existingWorker.Sid] {
+			workerSids[existingWorker.FriendlyName] = existingWorker.Sid
+		}
+	}
+
+	maxConcurrency := d.Get("max_concurrency").(int)
+	workspace := client.Workspace(workspaceSid)
+
+	errors := helper.RunConcurrently(ctx, len(plan.Delete), maxConcurrency, func(ctx context.Context, index int) error {
+		deleteWorker := plan.Delete[index]
+		if err := workspace.Worker(deleteWorker.Sid).DeleteWithContext(ctx); err != nil && !utils.IsNotFoundError(err) {
+			return fmt.Errorf("Failed to delete taskrouter worker (%s): %s", deleteWorker.FriendlyName, err.Error())
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(workerSids, deleteWorker.FriendlyName)
+		return nil
+	})
+
+	errors = append(errors, helper.RunConcurrently(ctx, len(plan.Update), maxConcurrency, func(ctx context.Context, index int) error {
+		updateWorker := plan.Update[index]
+		updateInput := &worker.UpdateWorkerInput{
+			ActivitySid: updateWorker.Configuration.ActivitySid,
+			Attributes:  sdkUtils.String(updateWorker.Configuration.Attributes),
+		}
+
+		if _, err := workspace.Worker(updateWorker.Sid).UpdateWithContext(ctx, updateInput); err != nil {
+			return fmt.Errorf("Failed to update taskrouter worker (%s): %s", updateWorker.Configuration.FriendlyName, err.Error())
+		}
+		return nil
+	})...)
+
+	errors = append(errors, helper.RunConcurrently(ctx, len(plan.Create), maxConcurrency, func(ctx context.Context, index int) error {
+		createWorker := plan.Create[index]
+		createInput := &workers.CreateWorkerInput{
+			FriendlyName: createWorker.FriendlyName,
+			ActivitySid:  createWorker.ActivitySid,
+			Attributes:   sdkUtils.String(createWorker.Attributes),
+		}
+
+		createResult, err := workspace.Workers.CreateWithContext(ctx, createInput)
+		if err != nil {
+			return fmt.Errorf("Failed to create taskrouter worker (%s): %s", createWorker.FriendlyName, err.Error())
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		workerSids[createWorker.FriendlyName] = createResult.Sid
+		return nil
+	})...)
+
+	log.Printf("[INFO] Reconciled taskrouter worker roster for workspace (%s): %d created, %d updated, %d deleted, %d failed", workspaceSid, len(plan.Create), len(plan.Update), len(plan.Delete), len(errors))
+
+	// The sids are stored before returning any errors so workers which were created are still managed by the roster
+	d.Set("worker_sids", workerSids)
+
+	return errorsToDiagnostics(errors)
+}
+
+func listTaskRouterRosterWorkers(ctx context.Context, client *taskrouter.TaskRouter, workspaceSid string) ([]helper.RosterWorker, error) {
+	paginator := client.Workspace(workspaceSid).Workers.NewWorkersPaginatorWithOptions(&workers.WorkersPageOptions{
+		PageSize: sdkUtils.Int(1000),
+	})
+	for paginator.NextWithContext(ctx) {
+	}
+
+	if err := paginator.Error(); err != nil {
+		return nil, err
+	}
+
+	rosterWorkers := []helper.RosterWorker{}
+	for _, worker := range paginator.Workers {
+		rosterWorkers = append(rosterWorkers, helper.RosterWorker{
+			Sid:          worker.Sid,
+			FriendlyName: worker.FriendlyName,
+			ActivitySid:  worker.ActivitySid,
+			Attributes:   worker.Attributes,
+		})
+	}
+	return rosterWorkers, nil
+}
+
+func managedTaskRouterRosterWorkerSids(workerSids map[string]interface{}) map[string]bool {
+	managedSids := make(map[string]bool)
+	for _, workerSid := range workerSids {
+		managedSids[workerSid.(string)] = true
+	}
+	return managedSids
+}
+
+func errorsToDiagnostics(errors []error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, err := range errors {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
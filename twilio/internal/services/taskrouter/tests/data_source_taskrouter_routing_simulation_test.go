@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTwilioTaskRouterRoutingSimulation_basic(t *testing.T) {
+	stateDataSourceName := "data.twilio_taskrouter_routing_simulation.routing_simulation"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioTaskRouterRoutingSimulation_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.0.task_name", "english sales"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.0.filter_friendly_name", "Sales"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.0.default_filter", "false"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.0.targets.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.0.targets.0.queue_sid", "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.0.targets.0.queue_friendly_name", "Sales"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.0.targets.0.eligible_workers.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.0.targets.0.eligible_workers.0", "Alice"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.1.task_name", "support"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.1.filter_friendly_name", ""),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.1.default_filter", "true"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.1.targets.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.1.targets.0.queue_sid", "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "results.1.targets.0.eligible_workers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterRoutingSimulation_undefinedTaskQueue(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterRoutingSimulation_undefinedTaskQueue(),
+				ExpectError: regexp.MustCompile(`(?s)Failed to simulate routing: Failed to simulate the default filter: The task queue \(WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb\) has not been defined`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioTaskRouterRoutingSimulation_basic() string {
+	return `
+data "twilio_taskrouter_workflow_configuration" "workflow_configuration" {
+  filters {
+    friendly_name = "Sales"
+    expression    = "type == 'sales'"
+
+    targets {
+      queue      = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+      expression = "task.language IN worker.languages"
+    }
+  }
+
+  default_filter {
+    queue = "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+  }
+}
+
+data "twilio_taskrouter_routing_simulation" "routing_simulation" {
+  workflow_configuration = data.twilio_taskrouter_workflow_configuration.workflow_configuration.json
+
+  task_queues {
+    sid            = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+    friendly_name  = "Sales"
+    target_workers = "skills HAS 'sales'"
+  }
+
+  task_queues {
+    sid           = "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+    friendly_name = "Everyone"
+  }
+
+  tasks {
+    name       = "english sales"
+    attributes = jsonencode({ type = "sales", language = "english" })
+  }
+
+  tasks {
+    name       = "support"
+    attributes = jsonencode({ type = "support" })
+  }
+
+  workers {
+    friendly_name = "Alice"
+    attributes    = jsonencode({ skills = ["sales"], languages = ["english"] })
+  }
+
+  workers {
+    friendly_name = "Bob"
+    attributes    = jsonencode({ skills = ["support"], languages = ["french"] })
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterRoutingSimulation_undefinedTaskQueue() string {
+	return `
+data "twilio_taskrouter_routing_simulation" "routing_simulation" {
+  workflow_configuration = jsonencode({
+    "task_routing" : {
+      "filters" : [],
+      "default_filter" : {
+        "queue" : "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+      }
+    }
+  })
+
+  task_queues {
+    sid = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  }
+
+  tasks {
+    name       = "support"
+    attributes = jsonencode({ type = "support" })
+  }
+}
+`
+}
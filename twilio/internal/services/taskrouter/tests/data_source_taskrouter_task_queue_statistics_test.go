@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const taskQueueStatisticsDataSourceName = "twilio_taskrouter_task_queue_statistics"
+
+func TestAccDataSourceTwilioTaskRouterTaskQueueStatistics_basic(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.task_queue_statistics", taskQueueStatisticsDataSourceName)
+	taskQueueStateResourceName := "twilio_taskrouter_task_queue.task_queue"
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioTaskRouterTaskQueueStatistics_basic(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "id", taskQueueStateResourceName, "sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "workspace_sid", taskQueueStateResourceName, "workspace_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "task_queue_sid", taskQueueStateResourceName, "sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.0.total_tasks", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.0.total_eligible_workers", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.0.total_available_workers", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.0.longest_task_waiting_age", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.#", "1"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "cumulative.0.start_time"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "cumulative.0.end_time"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.tasks_entered", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.reservations_accepted", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.reservations_rejected", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.wait_duration_in_queue_until_accepted.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterTaskQueueStatistics_invalidWorkspaceSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterTaskQueueStatistics_invalidWorkspaceSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of workspace_sid to match regular expression "\^WS\[0-9a-fA-F\]\{32\}\$", got workspace_sid`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterTaskQueueStatistics_invalidTaskQueueSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterTaskQueueStatistics_invalidTaskQueueSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of task_queue_sid to match regular expression "\^WQ\[0-9a-fA-F\]\{32\}\$", got task_queue_sid`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioTaskRouterTaskQueueStatistics_basic(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%[1]s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+
+resource "twilio_taskrouter_task_queue" "task_queue" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+  friendly_name = "%[1]s"
+}
+
+data "twilio_taskrouter_task_queue_statistics" "task_queue_statistics" {
+  workspace_sid  = twilio_taskrouter_task_queue.task_queue.workspace_sid
+  task_queue_sid = twilio_taskrouter_task_queue.task_queue.sid
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioTaskRouterTaskQueueStatistics_invalidWorkspaceSid() string {
+	return `
+data "twilio_taskrouter_task_queue_statistics" "task_queue_statistics" {
+  workspace_sid  = "workspace_sid"
+  task_queue_sid = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterTaskQueueStatistics_invalidTaskQueueSid() string {
+	return `
+data "twilio_taskrouter_task_queue_statistics" "task_queue_statistics" {
+  workspace_sid  = "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  task_queue_sid = "task_queue_sid"
+}
+`
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const topologyDataSourceName = "twilio_taskrouter_topology"
+
+func TestAccDataSourceTwilioTaskRouterTopology_basic(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.topology", topologyDataSourceName)
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioTaskRouterTopology_basic(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "id", "twilio_taskrouter_workspace.workspace", "sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "activities.#"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "workers.#", "1"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "workers.0.sid", "twilio_taskrouter_worker.worker", "sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "task_queues.#", "1"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "task_queues.0.sid", "twilio_taskrouter_task_queue.task_queue", "sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "task_queues.0.worker_sids.#", "1"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "task_queues.0.worker_sids.0", "twilio_taskrouter_worker.worker", "sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "workflows.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "workflows.0.filters.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "workflows.0.filters.0.filter_friendly_name", "Sales"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "workflows.0.filters.0.targets.0.queue_sid", "twilio_taskrouter_task_queue.task_queue", "sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "workflows.0.default_filter_queue_sid", "twilio_taskrouter_task_queue.task_queue", "sid"),
+					resource.TestMatchResourceAttr(stateDataSourceName, "mermaid", regexp.MustCompile(`^flowchart LR\n`)),
+					resource.TestMatchResourceAttr(stateDataSourceName, "dot", regexp.MustCompile(`^digraph \{\n`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterTopology_invalidWorkspaceSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterTopology_invalidWorkspaceSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of workspace_sid to match regular expression "\^WS\[0-9a-fA-F\]\{32\}\$", got workspace_sid`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioTaskRouterTopology_basic(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%[1]s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+
+resource "twilio_taskrouter_task_queue" "task_queue" {
+  workspace_sid  = twilio_taskrouter_workspace.workspace.sid
+  friendly_name  = "%[1]s"
+  target_workers = "skills HAS 'sales'"
+}
+
+resource "twilio_taskrouter_worker" "worker" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+  friendly_name = "%[1]s"
+  attributes = jsonencode({
+    "skills" : ["sales"]
+  })
+}
+
+resource "twilio_taskrouter_workflow" "workflow" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+  friendly_name = "%[1]s"
+  configuration = jsonencode({
+    "task_routing" : {
+      "filters" : [
+        {
+          "filter_friendly_name" : "Sales",
+          "expression" : "type == 'sales'",
+          "targets" : [
+            {
+              "queue" : twilio_taskrouter_task_queue.task_queue.sid
+            }
+          ]
+        }
+      ],
+      "default_filter" : {
+        "queue" : twilio_taskrouter_task_queue.task_queue.sid
+      }
+    }
+  })
+}
+
+data "twilio_taskrouter_topology" "topology" {
+  workspace_sid = twilio_taskrouter_workflow.workflow.workspace_sid
+
+  depends_on = [
+    twilio_taskrouter_worker.worker
+  ]
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioTaskRouterTopology_invalidWorkspaceSid() string {
+	return `
+data "twilio_taskrouter_topology" "topology" {
+  workspace_sid = "workspace_sid"
+}
+`
+}
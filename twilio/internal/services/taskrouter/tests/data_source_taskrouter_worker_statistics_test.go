@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const workerStatisticsDataSourceName = "twilio_taskrouter_worker_statistics"
+
+func TestAccDataSourceTwilioTaskRouterWorkerStatistics_basic(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.worker_statistics", workerStatisticsDataSourceName)
+	workerStateResourceName := "twilio_taskrouter_worker.worker"
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioTaskRouterWorkerStatistics_basic(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "id", workerStateResourceName, "sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "workspace_sid", workerStateResourceName, "workspace_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "worker_sid", workerStateResourceName, "sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.#", "1"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "cumulative.0.start_time"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "cumulative.0.end_time"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "cumulative.0.activity_durations.#"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.tasks_assigned", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.reservations_accepted", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.reservations_rejected", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkerStatistics_invalidWorkspaceSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkerStatistics_invalidWorkspaceSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of workspace_sid to match regular expression "\^WS\[0-9a-fA-F\]\{32\}\$", got workspace_sid`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkerStatistics_invalidWorkerSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkerStatistics_invalidWorkerSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of worker_sid to match regular expression "\^WK\[0-9a-fA-F\]\{32\}\$", got worker_sid`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioTaskRouterWorkerStatistics_basic(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%[1]s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+
+resource "twilio_taskrouter_worker" "worker" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+  friendly_name = "%[1]s"
+}
+
+data "twilio_taskrouter_worker_statistics" "worker_statistics" {
+  workspace_sid = twilio_taskrouter_worker.worker.workspace_sid
+  worker_sid    = twilio_taskrouter_worker.worker.sid
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioTaskRouterWorkerStatistics_invalidWorkspaceSid() string {
+	return `
+data "twilio_taskrouter_worker_statistics" "worker_statistics" {
+  workspace_sid = "workspace_sid"
+  worker_sid    = "WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkerStatistics_invalidWorkerSid() string {
+	return `
+data "twilio_taskrouter_worker_statistics" "worker_statistics" {
+  workspace_sid = "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  worker_sid    = "worker_sid"
+}
+`
+}
@@ -0,0 +1,229 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTwilioTaskRouterWorkflowConfiguration_basic(t *testing.T) {
+	stateDataSourceName := "data.twilio_taskrouter_workflow_configuration.workflow_configuration"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioTaskRouterWorkflowConfiguration_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"task_routing":{"filters":[],"default_filter":{"queue":"WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkflowConfiguration_complete(t *testing.T) {
+	stateDataSourceName := "data.twilio_taskrouter_workflow_configuration.workflow_configuration"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioTaskRouterWorkflowConfiguration_complete(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"task_routing":{"filters":[{"filter_friendly_name":"Sales","expression":"type == \"sales\"","targets":[{"queue":"WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","known_worker_sid":"task.worker_sid"},{"queue":"WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb","expression":"task.language IN worker.languages","priority":10,"timeout":300,"order_by":"worker.level DESC","skip_if":"workers.available == 0"}]}],"default_filter":{"queue":"WQcccccccccccccccccccccccccccccccc"}}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidQueueSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidQueueSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of filters.0.targets.0.queue to match regular expression "\^WQ\[0-9a-fA-F\]\{32\}\$", got queue`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidTimeout(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidTimeout(),
+				ExpectError: regexp.MustCompile(`(?s)expected filters.0.targets.0.timeout to be at least \(1\), got -1`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidPriority(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidPriority(),
+				ExpectError: regexp.MustCompile(`(?s)expected filters.0.targets.0.priority to be at least \(0\), got -1`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkflowConfiguration_knownWorkerConflict(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkflowConfiguration_knownWorkerConflict(),
+				ExpectError: regexp.MustCompile(`(?s)Only one of known_worker_sid or known_worker_friendly_name can be set on target 0 of filter 0`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidTargetExpression(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidTargetExpression(),
+				ExpectError: regexp.MustCompile(`(?s)expected filters.0.targets.0.expression to be a valid TaskRouter expression, unexpected end of expression, expected an attribute or value at position 38`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowConfiguration_basic() string {
+	return `
+data "twilio_taskrouter_workflow_configuration" "workflow_configuration" {
+  default_filter {
+    queue = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowConfiguration_complete() string {
+	return `
+data "twilio_taskrouter_workflow_configuration" "workflow_configuration" {
+  filters {
+    friendly_name = "Sales"
+    expression    = "type == \"sales\""
+
+    targets {
+      queue            = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+      known_worker_sid = "task.worker_sid"
+    }
+
+    targets {
+      queue      = "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+      expression = "task.language IN worker.languages"
+      priority   = 10
+      timeout    = 300
+      order_by   = "worker.level DESC"
+      skip_if    = "workers.available == 0"
+    }
+  }
+
+  default_filter {
+    queue = "WQcccccccccccccccccccccccccccccccc"
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidQueueSid() string {
+	return `
+data "twilio_taskrouter_workflow_configuration" "workflow_configuration" {
+  filters {
+    friendly_name = "Sales"
+    expression    = "type == \"sales\""
+
+    targets {
+      queue = "queue"
+    }
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidTimeout() string {
+	return `
+data "twilio_taskrouter_workflow_configuration" "workflow_configuration" {
+  filters {
+    friendly_name = "Sales"
+    expression    = "type == \"sales\""
+
+    targets {
+      queue   = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+      timeout = -1
+    }
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidPriority() string {
+	return `
+data "twilio_taskrouter_workflow_configuration" "workflow_configuration" {
+  filters {
+    friendly_name = "Sales"
+    expression    = "type == \"sales\""
+
+    targets {
+      queue    = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+      priority = -1
+    }
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowConfiguration_knownWorkerConflict() string {
+	return `
+data "twilio_taskrouter_workflow_configuration" "workflow_configuration" {
+  filters {
+    friendly_name = "Sales"
+    expression    = "type == \"sales\""
+
+    targets {
+      queue                      = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+      known_worker_sid           = "task.worker_sid"
+      known_worker_friendly_name = "task.worker_name"
+    }
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowConfiguration_invalidTargetExpression() string {
+	return `
+data "twilio_taskrouter_workflow_configuration" "workflow_configuration" {
+  filters {
+    friendly_name = "Sales"
+    expression    = "type == \"sales\""
+
+    targets {
+      queue      = "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+      expression = "task.language IN worker.languages AND"
+    }
+  }
+}
+`
+}
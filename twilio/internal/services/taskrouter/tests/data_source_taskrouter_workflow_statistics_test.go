@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const workflowStatisticsDataSourceName = "twilio_taskrouter_workflow_statistics"
+
+func TestAccDataSourceTwilioTaskRouterWorkflowStatistics_basic(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.workflow_statistics", workflowStatisticsDataSourceName)
+	workflowStateResourceName := "twilio_taskrouter_workflow.workflow"
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioTaskRouterWorkflowStatistics_basic(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "id", workflowStateResourceName, "sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "workspace_sid", workflowStateResourceName, "workspace_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "workflow_sid", workflowStateResourceName, "sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.0.total_tasks", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.0.longest_task_waiting_age", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.#", "1"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "cumulative.0.start_time"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "cumulative.0.end_time"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.tasks_entered", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.reservations_accepted", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.reservations_rejected", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.wait_duration_until_accepted.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkflowStatistics_invalidWorkspaceSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkflowStatistics_invalidWorkspaceSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of workspace_sid to match regular expression "\^WS\[0-9a-fA-F\]\{32\}\$", got workspace_sid`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkflowStatistics_invalidWorkflowSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkflowStatistics_invalidWorkflowSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of workflow_sid to match regular expression "\^WW\[0-9a-fA-F\]\{32\}\$", got workflow_sid`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowStatistics_basic(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%[1]s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+
+resource "twilio_taskrouter_task_queue" "task_queue" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+  friendly_name = "%[1]s"
+}
+
+resource "twilio_taskrouter_workflow" "workflow" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+  friendly_name = "%[1]s"
+  configuration = jsonencode({
+    "task_routing" : {
+      "filters" : [],
+      "default_filter" : {
+        "queue" : twilio_taskrouter_task_queue.task_queue.sid
+      }
+    }
+  })
+}
+
+data "twilio_taskrouter_workflow_statistics" "workflow_statistics" {
+  workspace_sid = twilio_taskrouter_workflow.workflow.workspace_sid
+  workflow_sid  = twilio_taskrouter_workflow.workflow.sid
+  minutes       = 15
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowStatistics_invalidWorkspaceSid() string {
+	return `
+data "twilio_taskrouter_workflow_statistics" "workflow_statistics" {
+  workspace_sid = "workspace_sid"
+  workflow_sid  = "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkflowStatistics_invalidWorkflowSid() string {
+	return `
+data "twilio_taskrouter_workflow_statistics" "workflow_statistics" {
+  workspace_sid = "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  workflow_sid  = "workflow_sid"
+}
+`
+}
@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const workspaceStatisticsDataSourceName = "twilio_taskrouter_workspace_statistics"
+
+func TestAccDataSourceTwilioTaskRouterWorkspaceStatistics_basic(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.workspace_statistics", workspaceStatisticsDataSourceName)
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioTaskRouterWorkspaceStatistics_basic(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "workspace_sid", "twilio_taskrouter_workspace.workspace", "sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.0.total_tasks", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.0.total_workers", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "real_time.0.longest_task_waiting_age", "0"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "real_time.0.activity_statistics.#"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.#", "1"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "cumulative.0.start_time"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "cumulative.0.end_time"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.tasks_created", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.reservations_accepted", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.reservations_rejected", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.wait_duration_until_accepted.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.split_by_wait_time.#", "2"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.split_by_wait_time.0.wait_time", "30"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "cumulative.0.split_by_wait_time.1.wait_time", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkspaceStatistics_invalidWorkspaceSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkspaceStatistics_invalidWorkspaceSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of workspace_sid to match regular expression "\^WS\[0-9a-fA-F\]\{32\}\$", got workspace_sid`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkspaceStatistics_invalidStartDate(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkspaceStatistics_invalidStartDate(),
+				ExpectError: regexp.MustCompile(`(?s)expected "start_date" to be a valid RFC3339 date, got "start_date"`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioTaskRouterWorkspaceStatistics_minutesAndStartDate(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioTaskRouterWorkspaceStatistics_minutesAndStartDate(),
+				ExpectError: regexp.MustCompile(`(?s)"minutes": conflicts with start_date`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioTaskRouterWorkspaceStatistics_basic(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+
+data "twilio_taskrouter_workspace_statistics" "workspace_statistics" {
+  workspace_sid      = twilio_taskrouter_workspace.workspace.sid
+  minutes            = 60
+  split_by_wait_time = [60, 30]
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioTaskRouterWorkspaceStatistics_invalidWorkspaceSid() string {
+	return `
+data "twilio_taskrouter_workspace_statistics" "workspace_statistics" {
+  workspace_sid = "workspace_sid"
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkspaceStatistics_invalidStartDate() string {
+	return `
+data "twilio_taskrouter_workspace_statistics" "workspace_statistics" {
+  workspace_sid = "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  start_date    = "start_date"
+}
+`
+}
+
+func testAccDataSourceTwilioTaskRouterWorkspaceStatistics_minutesAndStartDate() string {
+	return `
+data "twilio_taskrouter_workspace_statistics" "workspace_statistics" {
+  workspace_sid = "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  minutes       = 60
+  start_date    = "2021-01-01T00:00:00Z"
+}
+`
+}
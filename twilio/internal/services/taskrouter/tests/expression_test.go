@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+)
+
+func TestValidateTaskRouterExpression(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		context    helper.ExpressionContext
+		err        string
+		warning    string
+	}{
+		{name: "match all", expression: "1==1", context: helper.WorkerExpressionContext},
+		{name: "equals", expression: `type == "sales"`, context: helper.TaskExpressionContext},
+		{name: "single equals", expression: "priority = 1", context: helper.TaskExpressionContext},
+		{name: "has", expression: "skills HAS 'support'", context: helper.WorkerExpressionContext},
+		{name: "in list", expression: "language IN ['english', 'spanish']", context: helper.TaskExpressionContext},
+		{name: "not in", expression: "language NOT IN ['english']", context: helper.TaskExpressionContext},
+		{name: "contains", expression: "name CONTAINS 'smith'", context: helper.WorkerExpressionContext},
+		{name: "logical operators", expression: "(level > 5 and department == 'sales') OR vip == true", context: helper.TaskExpressionContext},
+		{name: "symbol logical operators", expression: "level >= 5 && level <= 10 || vip", context: helper.TaskExpressionContext},
+		{name: "target expression", expression: "task.language IN worker.languages", context: helper.TargetExpressionContext},
+		{name: "taskrouter attributes", expression: "taskrouter.dayOfWeek IN ['Mon', 'Tue']", context: helper.TargetExpressionContext},
+		{name: "empty", expression: " ", context: helper.TaskExpressionContext, err: "expression cannot be empty at position 1"},
+		{name: "unterminated string", expression: "type == 'sales", context: helper.TaskExpressionContext, err: "unterminated string at position 9"},
+		{name: "missing operand", expression: "type ==", context: helper.TaskExpressionContext, err: "unexpected end of expression, expected an attribute or value at position 8"},
+		{name: "missing operator", expression: "type 'sales'", context: helper.TaskExpressionContext, err: `unexpected string "sales", expected a comparison operator at position 6`},
+		{name: "unbalanced parenthesis", expression: "(type == 'sales'", context: helper.TaskExpressionContext, err: `unexpected end of expression, expected ")" at position 17`},
+		{name: "invalid character", expression: "type ! 'sales'", context: helper.TaskExpressionContext, err: `unexpected character "!" at position 6`},
+		{name: "unterminated list", expression: "language IN ['english' 'spanish']", context: helper.TaskExpressionContext, err: `unexpected string "spanish", expected "," or "]" at position 24`},
+		{name: "trailing logical operator", expression: "type == 'sales' AND", context: helper.TaskExpressionContext, err: "unexpected end of expression, expected an attribute or value at position 20"},
+		{name: "not without in", expression: "language NOT HAS 'english'", context: helper.TaskExpressionContext, err: `unexpected "NOT", expected a comparison operator at position 10`},
+		{name: "unprefixed target attribute", expression: "language IN worker.languages", context: helper.TargetExpressionContext, warning: `attribute "language" is not prefixed with task. or worker. at position 1`},
+		{name: "worker attribute in filter", expression: "worker.level > 5", context: helper.TaskExpressionContext, warning: `worker attribute "worker.level" is referenced in a workflow filter expression at position 1`},
+		{name: "task attribute in target workers", expression: "skills HAS task.skill", context: helper.WorkerExpressionContext, warning: `task attribute "task.skill" is referenced in a target workers expression at position 12`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			warnings, err := helper.ValidateExpression(testCase.expression, testCase.context)
+			if testCase.err == "" {
+				if err != nil {
+					t.Fatalf("Expected expression to be valid, got: %s", err.Error())
+				}
+				if testCase.warning == "" && len(warnings) != 0 {
+					t.Fatalf("Expected no warnings, got: %v", warnings)
+				}
+				if testCase.warning != "" && (len(warnings) != 1 || warnings[0] != testCase.warning) {
+					t.Fatalf("Expected warning %q, got: %v", testCase.warning, warnings)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != testCase.err {
+				t.Fatalf("Expected error %q, got: %v", testCase.err, err)
+			}
+		})
+	}
+}
+
+func TestExpressionValidationAsWarnings(t *testing.T) {
+	warnings, errors := helper.ExpressionValidationAsWarnings(helper.WorkerExpressionContext)("languages HAS 'english", "target_workers")
+	if len(errors) != 0 {
+		t.Fatalf("Expected no errors, got: %v", errors)
+	}
+
+	expectedWarning := "expected target_workers to be a valid TaskRouter expression, unterminated string at position 15. The expression will be validated by Twilio when it is applied"
+	if len(warnings) != 1 || warnings[0] != expectedWarning {
+		t.Fatalf("Expected warning %q, got: %v", expectedWarning, warnings)
+	}
+}
+
+func TestWorkflowConfigurationValidationAsWarnings(t *testing.T) {
+	configuration := `{"task_routing":{"filters":[{"filter_friendly_name":"Sales","expression":"type ==","targets":[{"queue":"WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}]}],"default_filter":{"queue":"WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}}}`
+
+	warnings, errors := helper.WorkflowConfigurationValidationAsWarnings()(configuration, "configuration")
+	if len(errors) != 0 {
+		t.Fatalf("Expected no errors, got: %v", errors)
+	}
+
+	expectedWarning := "expected the expression of filter 0 in configuration to be a valid TaskRouter expression, unexpected end of expression, expected an attribute or value at position 8. The expression will be validated by Twilio when it is applied"
+	if len(warnings) != 1 || warnings[0] != expectedWarning {
+		t.Fatalf("Expected warning %q, got: %v", expectedWarning, warnings)
+	}
+}
+
+func TestEvaluateTaskRouterExpression(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		attributes map[string]interface{}
+		expected   bool
+	}{
+		{name: "equals", expression: "team == 'sales'", attributes: map[string]interface{}{"team": "sales"}, expected: true},
+		{name: "equals missing attribute", expression: "team == 'sales'", attributes: map[string]interface{}{}, expected: false},
+		{name: "not equals", expression: "team != 'sales'", attributes: map[string]interface{}{"team": "support"}, expected: true},
+		{name: "not equals same value", expression: "team != 'sales'", attributes: map[string]interface{}{"team": "sales"}, expected: false},
+		{name: "not equals missing attribute", expression: "team != 'sales'", attributes: map[string]interface{}{}, expected: false},
+		{name: "not in", expression: "team NOT IN ['sales']", attributes: map[string]interface{}{"team": "support"}, expected: true},
+		{name: "not in missing attribute", expression: "team NOT IN ['sales']", attributes: map[string]interface{}{}, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			expression, err := helper.ParseExpression(testCase.expression)
+			if err != nil {
+				t.Fatalf("err: %s", err.Error())
+			}
+
+			if result := helper.EvaluateExpression(expression, testCase.attributes); result != testCase.expected {
+				t.Fatalf("Expected %t, got: %t", testCase.expected, result)
+			}
+		})
+	}
+}
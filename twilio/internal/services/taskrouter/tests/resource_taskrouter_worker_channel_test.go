@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const workerChannelResourceName = "twilio_taskrouter_worker_channel"
+
+func TestAccTwilioTaskRouterWorkerChannel_basic(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.worker_channel", workerChannelResourceName)
+	workerStateResourceName := "twilio_taskrouter_worker.worker"
+
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioTaskRouterWorkerChannel_basic(friendlyName, 3, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioTaskRouterWorkerChannelExists(stateResourceName),
+					resource.TestCheckResourceAttrPair(stateResourceName, "id", stateResourceName, "sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "sid"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "account_sid"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "workspace_sid", workerStateResourceName, "workspace_sid"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "worker_sid", workerStateResourceName, "sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "task_channel", "chat"),
+					resource.TestCheckResourceAttr(stateResourceName, "capacity", "3"),
+					resource.TestCheckResourceAttr(stateResourceName, "available", "true"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "task_channel_sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "task_channel_unique_name", "chat"),
+					resource.TestCheckResourceAttr(stateResourceName, "assigned_tasks", "0"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "available_capacity_percentage"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "date_created"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "url"),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioTaskRouterWorkerChannelImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTwilioTaskRouterWorkerChannel_update(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.worker_channel", workerChannelResourceName)
+
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioTaskRouterWorkerChannel_basic(friendlyName, 3, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioTaskRouterWorkerChannelExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "capacity", "3"),
+					resource.TestCheckResourceAttr(stateResourceName, "available", "true"),
+				),
+			},
+			{
+				Config: testAccTwilioTaskRouterWorkerChannel_basic(friendlyName, 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioTaskRouterWorkerChannelExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "capacity", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "available", "false"),
+				),
+			},
+			{
+				Config: testAccTwilioTaskRouterWorkerChannel_basic(friendlyName, 0, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioTaskRouterWorkerChannelExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "capacity", "0"),
+					resource.TestCheckResourceAttr(stateResourceName, "available", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioTaskRouterWorkerChannel_invalidWorkerSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioTaskRouterWorkerChannel_invalidWorkerSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of worker_sid to match regular expression "\^WK\[0-9a-fA-F\]\{32\}\$", got worker_sid`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioTaskRouterWorkerChannel_invalidCapacity(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioTaskRouterWorkerChannel_invalidCapacity(),
+				ExpectError: regexp.MustCompile(`(?s)expected capacity to be at least \(0\), got -1`),
+			},
+		},
+	})
+}
+
+func testAccCheckTwilioTaskRouterWorkerChannelExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).TaskRouter
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if _, err := client.Workspace(rs.Primary.Attributes["workspace_sid"]).Worker(rs.Primary.Attributes["worker_sid"]).Channel(rs.Primary.Attributes["task_channel"]).Fetch(); err != nil {
+			return fmt.Errorf("Error occurred when retrieving worker channel information %s", err.Error())
+		}
+
+		return nil
+	}
+}
+
+func testAccTwilioTaskRouterWorkerChannelImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("/Workspaces/%s/Workers/%s/Channels/%s", rs.Primary.Attributes["workspace_sid"], rs.Primary.Attributes["worker_sid"], rs.Primary.Attributes["task_channel"]), nil
+	}
+}
+
+func testAccTwilioTaskRouterWorkerChannel_basic(friendlyName string, capacity int, available bool) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%[1]s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+
+resource "twilio_taskrouter_worker" "worker" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+  friendly_name = "%[1]s"
+}
+
+resource "twilio_taskrouter_worker_channel" "worker_channel" {
+  workspace_sid = twilio_taskrouter_worker.worker.workspace_sid
+  worker_sid    = twilio_taskrouter_worker.worker.sid
+  task_channel  = "chat"
+  capacity      = %[2]d
+  available     = %[3]t
+}
+`, friendlyName, capacity, available)
+}
+
+func testAccTwilioTaskRouterWorkerChannel_invalidWorkerSid() string {
+	return `
+resource "twilio_taskrouter_worker_channel" "worker_channel" {
+  workspace_sid = "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  worker_sid    = "worker_sid"
+  task_channel  = "chat"
+}
+`
+}
+
+func testAccTwilioTaskRouterWorkerChannel_invalidCapacity() string {
+	return `
+resource "twilio_taskrouter_worker_channel" "worker_channel" {
+  workspace_sid = "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  worker_sid    = "WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  task_channel  = "chat"
+  capacity      = -1
+}
+`
+}
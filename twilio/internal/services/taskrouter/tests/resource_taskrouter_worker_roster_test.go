@@ -0,0 +1,390 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/common"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/utils"
+	"github.com/RJPearson94/twilio-sdk-go/service/taskrouter/v1/workspace/workers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const workerRosterResourceName = "twilio_taskrouter_worker_roster"
+
+func TestAccTwilioTaskRouterWorkerRoster_basic(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.worker_roster", workerRosterResourceName)
+	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
+
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioTaskRouterWorkerRosterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioTaskRouterWorkerRoster_basic(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioTaskRouterWorkerRosterExists(stateResourceName),
+					resource.TestCheckResourceAttrPair(stateResourceName, "id", workspaceStateResourceName, "sid"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "workspace_sid", workspaceStateResourceName, "sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "workers.%", "2"),
+					resource.TestCheckResourceAttr(stateResourceName, "workers.alice", `{"skills":["sales"]}`),
+					resource.TestCheckResourceAttr(stateResourceName, "workers.bob", "{}"),
+					resource.TestCheckResourceAttr(stateResourceName, "unmanaged_workers", "ignore"),
+					resource.TestCheckResourceAttr(stateResourceName, "max_concurrency", "10"),
+					resource.TestCheckResourceAttr(stateResourceName, "worker_sids.%", "2"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "worker_sids.alice"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "worker_sids.bob"),
+				),
+			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTwilioTaskRouterWorkerRosterImportStateIdFunc(stateResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTwilioTaskRouterWorkerRoster_update(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.worker_roster", workerRosterResourceName)
+	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
+
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioTaskRouterWorkerRosterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioTaskRouterWorkerRoster_basic(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioTaskRouterWorkerRosterExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "workers.%", "2"),
+					resource.TestCheckResourceAttr(stateResourceName, "worker_sids.%", "2"),
+				),
+			},
+			{
+				Config: testAccTwilioTaskRouterWorkerRoster_update(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioTaskRouterWorkerRosterExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "workers.%", "2"),
+					resource.TestCheckResourceAttr(stateResourceName, "workers.alice", `{"skills":["sales","support"]}`),
+					resource.TestCheckResourceAttr(stateResourceName, "workers.carol", "{}"),
+					resource.TestCheckNoResourceAttr(stateResourceName, "workers.bob"),
+					resource.TestCheckResourceAttr(stateResourceName, "activity_sids.%", "1"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "activity_sids.carol", "twilio_taskrouter_activity.activity", "sid"),
+					resource.TestCheckResourceAttr(stateResourceName, "worker_sids.%", "2"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "worker_sids.alice"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "worker_sids.carol"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "workspace_sid", workspaceStateResourceName, "sid"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioTaskRouterWorkerRoster_unmanagedWorker(t *testing.T) {
+	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioTaskRouterWorkerRosterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioTaskRouterWorkerRoster_workspace(friendlyName),
+				Check:  testAccCreateTwilioTaskRouterUnmanagedWorker(workspaceStateResourceName, "alice"),
+			},
+			{
+				Config:      testAccTwilioTaskRouterWorkerRoster_unmanagedWorker(friendlyName, "ignore"),
+				ExpectError: regexp.MustCompile(`(?s)The worker \(alice\) already exists in the workspace with sid \(WK[0-9a-fA-F]{32}\) and is not managed by the roster`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioTaskRouterWorkerRoster_adoptUnmanagedWorker(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.worker_roster", workerRosterResourceName)
+	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
+	friendlyName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioTaskRouterWorkerRosterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioTaskRouterWorkerRoster_workspace(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCreateTwilioTaskRouterUnmanagedWorker(workspaceStateResourceName, "alice"),
+					testAccCreateTwilioTaskRouterUnmanagedWorker(workspaceStateResourceName, "bob"),
+				),
+			},
+			{
+				Config: testAccTwilioTaskRouterWorkerRoster_unmanagedWorker(friendlyName, "adopt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioTaskRouterWorkerRosterExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "unmanaged_workers", "adopt"),
+					resource.TestCheckResourceAttr(stateResourceName, "workers.%", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "workers.alice", `{"skills":["sales"]}`),
+					resource.TestCheckResourceAttr(stateResourceName, "worker_sids.%", "1"),
+					resource.TestCheckResourceAttrSet(stateResourceName, "worker_sids.alice"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioTaskRouterWorkerRoster_invalidWorkspaceSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioTaskRouterWorkerRoster_invalidWorkspaceSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of workspace_sid to match regular expression "\^WS\[0-9a-fA-F\]\{32\}\$", got workspace_sid`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioTaskRouterWorkerRoster_invalidAttributes(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioTaskRouterWorkerRoster_invalidAttributes(),
+				ExpectError: regexp.MustCompile(`(?s)The attributes of worker \(alice\) must be a JSON string`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioTaskRouterWorkerRoster_activitySidForUnknownWorker(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioTaskRouterWorkerRoster_activitySidForUnknownWorker(),
+				ExpectError: regexp.MustCompile(`(?s)The activity sid for worker \(bob\) has been specified but the worker is not in the roster`),
+			},
+		},
+	})
+}
+
+func testAccCheckTwilioTaskRouterWorkerRosterDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).TaskRouter
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != workerRosterResourceName {
+			continue
+		}
+
+		for key, workerSid := range rs.Primary.Attributes {
+			if !regexp.MustCompile(`^worker_sids\.[^%]`).MatchString(key) {
+				continue
+			}
+
+			if _, err := client.Workspace(rs.Primary.ID).Worker(workerSid).Fetch(); err != nil {
+				if utils.IsNotFoundError(err) {
+					continue
+				}
+				return fmt.Errorf("Error occurred when retrieving worker information %s", err.Error())
+			}
+			return fmt.Errorf("The worker (%s) managed by the roster has not been deleted", workerSid)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTwilioTaskRouterWorkerRosterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).TaskRouter
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		for key, workerSid := range rs.Primary.Attributes {
+			if !regexp.MustCompile(`^worker_sids\.[^%]`).MatchString(key) {
+				continue
+			}
+
+			if _, err := client.Workspace(rs.Primary.ID).Worker(workerSid).Fetch(); err != nil {
+				return fmt.Errorf("Error occurred when retrieving worker information %s", err.Error())
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCreateTwilioTaskRouterUnmanagedWorker creates a worker outside of Terraform so the roster can be tested against workers it does not manage
+func testAccCreateTwilioTaskRouterUnmanagedWorker(workspaceName string, workerFriendlyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).TaskRouter
+
+		rs, ok := s.RootModule().Resources[workspaceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", workspaceName)
+		}
+
+		if _, err := client.Workspace(rs.Primary.ID).Workers.Create(&workers.CreateWorkerInput{FriendlyName: workerFriendlyName}); err != nil {
+			return fmt.Errorf("Error occurred when creating worker %s", err.Error())
+		}
+
+		return nil
+	}
+}
+
+func testAccTwilioTaskRouterWorkerRosterImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		workerSids := []string{}
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, "worker_sids.") && key != "worker_sids.%" {
+				workerSids = append(workerSids, value)
+			}
+		}
+		sort.Strings(workerSids)
+
+		return fmt.Sprintf("/Workspaces/%s/WorkerRoster/Workers/%s", rs.Primary.Attributes["workspace_sid"], strings.Join(workerSids, ",")), nil
+	}
+}
+
+func testAccTwilioTaskRouterWorkerRoster_basic(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+
+resource "twilio_taskrouter_worker_roster" "worker_roster" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+
+  workers = {
+    alice = jsonencode({ skills = ["sales"] })
+    bob   = "{}"
+  }
+}
+`, friendlyName)
+}
+
+func testAccTwilioTaskRouterWorkerRoster_update(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%[1]s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+
+resource "twilio_taskrouter_activity" "activity" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+  friendly_name = "%[1]s"
+  available     = true
+}
+
+resource "twilio_taskrouter_worker_roster" "worker_roster" {
+  workspace_sid = twilio_taskrouter_workspace.workspace.sid
+
+  workers = {
+    alice = jsonencode({ skills = ["sales", "support"] })
+    carol = "{}"
+  }
+
+  activity_sids = {
+    carol = twilio_taskrouter_activity.activity.sid
+  }
+}
+`, friendlyName)
+}
+
+func testAccTwilioTaskRouterWorkerRoster_workspace(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+`, friendlyName)
+}
+
+func testAccTwilioTaskRouterWorkerRoster_unmanagedWorker(friendlyName string, unmanagedWorkers string) string {
+	return fmt.Sprintf(`
+resource "twilio_taskrouter_workspace" "workspace" {
+  friendly_name          = "%[1]s"
+  multi_task_enabled     = true
+  prioritize_queue_order = "FIFO"
+}
+
+resource "twilio_taskrouter_worker_roster" "worker_roster" {
+  workspace_sid     = twilio_taskrouter_workspace.workspace.sid
+  unmanaged_workers = "%[2]s"
+
+  workers = {
+    alice = jsonencode({ skills = ["sales"] })
+  }
+}
+`, friendlyName, unmanagedWorkers)
+}
+
+func testAccTwilioTaskRouterWorkerRoster_invalidWorkspaceSid() string {
+	return `
+resource "twilio_taskrouter_worker_roster" "worker_roster" {
+  workspace_sid = "workspace_sid"
+
+  workers = {
+    alice = "{}"
+  }
+}
+`
+}
+
+func testAccTwilioTaskRouterWorkerRoster_invalidAttributes() string {
+	return `
+resource "twilio_taskrouter_worker_roster" "worker_roster" {
+  workspace_sid = "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+  workers = {
+    alice = "attributes"
+  }
+}
+`
+}
+
+func testAccTwilioTaskRouterWorkerRoster_activitySidForUnknownWorker() string {
+	return `
+resource "twilio_taskrouter_worker_roster" "worker_roster" {
+  workspace_sid = "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+  workers = {
+    alice = "{}"
+  }
+
+  activity_sids = {
+    bob = "WAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  }
+}
+`
+}
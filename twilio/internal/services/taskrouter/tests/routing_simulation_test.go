@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+)
+
+func TestSimulateTaskRouterRouting(t *testing.T) {
+	configuration, err := helper.ParseWorkflowConfiguration(`{"task_routing":{"filters":[{"filter_friendly_name":"Sales","expression":"type == 'sales'","targets":[{"queue":"WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","expression":"task.language IN worker.languages"},{"queue":"WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}]},{"filter_friendly_name":"VIP","expression":"vip == true","targets":[{"queue":"WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb","known_worker_friendly_name":"task.account_manager"}]}],"default_filter":{"queue":"WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}}}`)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	taskQueues := []helper.SimulationTaskQueue{
+		{Sid: "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Sales", TargetWorkers: "skills HAS 'sales'"},
+		{Sid: "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", FriendlyName: "Everyone"},
+	}
+	tasks := []helper.SimulationTask{
+		{Name: "english sales", Attributes: map[string]interface{}{"type": "sales", "language": "english"}},
+		{Name: "vip", Attributes: map[string]interface{}{"type": "support", "vip": true, "account_manager": "Bob"}},
+		{Name: "support", Attributes: map[string]interface{}{"type": "support"}},
+	}
+	workers := []helper.SimulationWorker{
+		{FriendlyName: "Alice", Attributes: map[string]interface{}{"skills": []interface{}{"sales"}, "languages": []interface{}{"english", "spanish"}}},
+		{FriendlyName: "Bob", Attributes: map[string]interface{}{"skills": []interface{}{"sales"}, "languages": []interface{}{"french"}}},
+		{FriendlyName: "Carol", Attributes: map[string]interface{}{"skills": []interface{}{"support"}}},
+	}
+
+	results, err := helper.SimulateRouting(configuration, taskQueues, tasks, workers)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	expected := []helper.SimulationResult{
+		{
+			TaskName:           "english sales",
+			FilterFriendlyName: "Sales",
+			Targets: []helper.SimulationTargetResult{
+				{QueueSid: "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", QueueFriendlyName: "Sales", EligibleWorkers: []string{"Alice"}},
+				{QueueSid: "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", QueueFriendlyName: "Everyone", EligibleWorkers: []string{"Alice", "Bob", "Carol"}},
+			},
+		},
+		{
+			TaskName:           "vip",
+			FilterFriendlyName: "VIP",
+			Targets: []helper.SimulationTargetResult{
+				{QueueSid: "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", QueueFriendlyName: "Everyone", EligibleWorkers: []string{"Bob"}},
+			},
+		},
+		{
+			TaskName:      "support",
+			DefaultFilter: true,
+			Targets: []helper.SimulationTargetResult{
+				{QueueSid: "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", QueueFriendlyName: "Everyone", EligibleWorkers: []string{"Alice", "Bob", "Carol"}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("Expected results:\n%+v\nGot:\n%+v", expected, results)
+	}
+}
+
+func TestSimulateTaskRouterRouting_undefinedTaskQueue(t *testing.T) {
+	configuration, err := helper.ParseWorkflowConfiguration(`{"task_routing":{"filters":[],"default_filter":{"queue":"WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}}}`)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	_, err = helper.SimulateRouting(configuration, []helper.SimulationTaskQueue{}, []helper.SimulationTask{{Name: "task", Attributes: map[string]interface{}{}}}, []helper.SimulationWorker{})
+	if err == nil || err.Error() != "Failed to simulate the default filter: The task queue (WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa) has not been defined" {
+		t.Fatalf("Expected undefined task queue error, got: %v", err)
+	}
+}
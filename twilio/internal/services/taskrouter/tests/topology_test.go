@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+)
+
+func testTaskRouterTopology(t *testing.T) *helper.Topology {
+	configuration, err := helper.ParseWorkflowConfiguration(`{"task_routing":{"filters":[{"filter_friendly_name":"Sales","expression":"type == \"sales\"","targets":[{"queue":"WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","expression":"task.language IN worker.languages"}]}],"default_filter":{"queue":"WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}}}`)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	activities := []helper.TopologyActivity{
+		{Sid: "WAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Available", Available: true},
+	}
+	taskQueues := []helper.TopologyTaskQueue{
+		{Sid: "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Sales", TargetWorkers: "skills HAS 'sales'"},
+		{Sid: "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", FriendlyName: "Everyone"},
+	}
+	workers := []helper.TopologyWorker{
+		{Sid: "WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Alice", ActivitySid: "WAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Attributes: map[string]interface{}{"skills": []interface{}{"sales"}}},
+		{Sid: "WKbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", FriendlyName: "Bob", Attributes: map[string]interface{}{"skills": []interface{}{"support"}}},
+	}
+	workflows := []helper.TopologyWorkflow{
+		{Sid: "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Routing", Configuration: configuration},
+	}
+
+	return helper.BuildTopology(activities, taskQueues, workers, workflows)
+}
+
+func TestBuildTaskRouterTopology(t *testing.T) {
+	topology := testTaskRouterTopology(t)
+
+	expected := []helper.TopologyTaskQueue{
+		{Sid: "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Sales", TargetWorkers: "skills HAS 'sales'", WorkerSids: []string{"WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}},
+		{Sid: "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", FriendlyName: "Everyone", WorkerSids: []string{"WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "WKbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}},
+	}
+
+	if !reflect.DeepEqual(topology.TaskQueues, expected) {
+		t.Errorf("Expected task queues %+v but got %+v", expected, topology.TaskQueues)
+	}
+}
+
+func TestBuildTaskRouterTopology_invalidTargetWorkers(t *testing.T) {
+	taskQueues := []helper.TopologyTaskQueue{
+		{Sid: "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Sales", TargetWorkers: "skills HAS"},
+		{Sid: "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", FriendlyName: "Everyone"},
+	}
+	workers := []helper.TopologyWorker{
+		{Sid: "WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Alice", Attributes: map[string]interface{}{"skills": []interface{}{"sales"}}},
+	}
+
+	topology := helper.BuildTopology(nil, taskQueues, workers, nil)
+
+	expected := []helper.TopologyTaskQueue{
+		{Sid: "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Sales", TargetWorkers: "skills HAS", TargetWorkersError: "unexpected end of expression, expected an attribute or value at position 11", WorkerSids: []string{}},
+		{Sid: "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", FriendlyName: "Everyone", WorkerSids: []string{"WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}},
+	}
+
+	if !reflect.DeepEqual(topology.TaskQueues, expected) {
+		t.Errorf("Expected task queues %+v but got %+v", expected, topology.TaskQueues)
+	}
+}
+
+func TestBuildTaskRouterTopology_invalidWorkflowConfiguration(t *testing.T) {
+	workflows := []helper.TopologyWorkflow{
+		{Sid: "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", FriendlyName: "Routing", ConfigurationError: "unexpected end of JSON input"},
+	}
+
+	topology := helper.BuildTopology(nil, nil, nil, workflows)
+
+	expected := `flowchart LR
+    WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa["Workflow: Routing"]
+`
+	if mermaid := topology.Mermaid(); mermaid != expected {
+		t.Errorf("Expected mermaid:\n%s\nbut got:\n%s", expected, mermaid)
+	}
+}
+
+func TestTaskRouterTopologyMermaid(t *testing.T) {
+	topology := testTaskRouterTopology(t)
+
+	expected := `flowchart LR
+    WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa["Workflow: Routing"]
+    WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_filter_0{"Filter: Sales"}
+    WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa[("Task Queue: Sales")]
+    WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_default_filter{"Default Filter"}
+    WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb[("Task Queue: Everyone")]
+    WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa("Worker: Alice")
+    WKbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb("Worker: Bob")
+    WAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa(["Activity: Available"])
+    WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa -->|"type == #quot;sales#quot;"| WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_filter_0
+    WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_filter_0 -->|"task.language IN worker.languages"| WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa
+    WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa --> WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_default_filter
+    WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_default_filter --> WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb
+    WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa --> WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa
+    WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb --> WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa
+    WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb --> WKbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb
+    WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa -.-> WAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa
+`
+
+	if mermaid := topology.Mermaid(); mermaid != expected {
+		t.Errorf("Expected mermaid:\n%s\nbut got:\n%s", expected, mermaid)
+	}
+}
+
+func TestTaskRouterTopologyDOT(t *testing.T) {
+	topology := testTaskRouterTopology(t)
+
+	expected := `digraph {
+  rankdir=LR;
+  "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" [label="Workflow: Routing", shape=box];
+  "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_filter_0" [label="Filter: Sales", shape=diamond];
+  "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" [label="Task Queue: Sales", shape=cylinder];
+  "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_default_filter" [label="Default Filter", shape=diamond];
+  "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" [label="Task Queue: Everyone", shape=cylinder];
+  "WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" [label="Worker: Alice", shape=ellipse];
+  "WKbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" [label="Worker: Bob", shape=ellipse];
+  "WAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" [label="Activity: Available", shape=oval];
+  "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" -> "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_filter_0" [label="type == \"sales\""];
+  "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_filter_0" -> "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" [label="task.language IN worker.languages"];
+  "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" -> "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_default_filter";
+  "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_default_filter" -> "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb";
+  "WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" -> "WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa";
+  "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" -> "WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa";
+  "WQbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" -> "WKbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb";
+  "WKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" -> "WAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" [style=dashed];
+}
+`
+
+	if dot := topology.DOT(); dot != expected {
+		t.Errorf("Expected dot:\n%s\nbut got:\n%s", expected, dot)
+	}
+}
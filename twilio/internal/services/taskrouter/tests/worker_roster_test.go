@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/taskrouter/helper"
+	sdkUtils "github.com/RJPearson94/twilio-sdk-go/utils"
+)
+
+func TestPlanTaskRouterWorkerRoster(t *testing.T) {
+	existing := []helper.RosterWorker{
+		{Sid: "WK1", FriendlyName: "Alice", ActivitySid: "WA1", Attributes: `{"skills": ["sales"]}`},
+		{Sid: "WK2", FriendlyName: "Bob", ActivitySid: "WA1", Attributes: `{}`},
+		{Sid: "WK3", FriendlyName: "Carol", ActivitySid: "WA1", Attributes: `{}`},
+		{Sid: "WK4", FriendlyName: "Dave", ActivitySid: "WA1", Attributes: `{}`},
+	}
+	managedSids := map[string]bool{"WK1": true, "WK2": true, "WK3": true}
+	desired := map[string]helper.RosterWorkerConfiguration{
+		"Alice": {FriendlyName: "Alice", Attributes: `{"skills":["sales"]}`},
+		"Bob":   {FriendlyName: "Bob", Attributes: `{"skills":["support"]}`},
+		"Erin":  {FriendlyName: "Erin", Attributes: `{}`, ActivitySid: sdkUtils.String("WA2")},
+	}
+
+	plan, err := helper.PlanWorkerRoster(existing, managedSids, desired, false)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	expected := &helper.WorkerRosterPlan{
+		Create: []helper.RosterWorkerConfiguration{desired["Erin"]},
+		Update: []helper.RosterWorkerUpdate{{Sid: "WK2", Configuration: desired["Bob"]}},
+		Delete: []helper.RosterWorker{existing[2]},
+	}
+	if !reflect.DeepEqual(plan, expected) {
+		t.Errorf("expected %+v, got %+v", expected, plan)
+	}
+}
+
+func TestPlanTaskRouterWorkerRoster_activityChange(t *testing.T) {
+	existing := []helper.RosterWorker{
+		{Sid: "WK1", FriendlyName: "Alice", ActivitySid: "WA1", Attributes: `{}`},
+	}
+	desired := map[string]helper.RosterWorkerConfiguration{
+		"Alice": {FriendlyName: "Alice", Attributes: `{}`, ActivitySid: sdkUtils.String("WA2")},
+	}
+
+	plan, err := helper.PlanWorkerRoster(existing, map[string]bool{"WK1": true}, desired, false)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if len(plan.Update) != 1 || plan.Update[0].Sid != "WK1" {
+		t.Errorf("expected worker WK1 to be updated, got %+v", plan.Update)
+	}
+}
+
+func TestPlanTaskRouterWorkerRoster_adopt(t *testing.T) {
+	existing := []helper.RosterWorker{
+		{Sid: "WK1", FriendlyName: "Alice", Attributes: `{}`},
+		{Sid: "WK2", FriendlyName: "Bob", Attributes: `{}`},
+	}
+	desired := map[string]helper.RosterWorkerConfiguration{
+		"Alice": {FriendlyName: "Alice", Attributes: `{"skills":["sales"]}`},
+	}
+
+	plan, err := helper.PlanWorkerRoster(existing, map[string]bool{}, desired, true)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	expected := &helper.WorkerRosterPlan{
+		Create: []helper.RosterWorkerConfiguration{},
+		Update: []helper.RosterWorkerUpdate{{Sid: "WK1", Configuration: desired["Alice"]}},
+		Delete: []helper.RosterWorker{existing[1]},
+	}
+	if !reflect.DeepEqual(plan, expected) {
+		t.Errorf("expected %+v, got %+v", expected, plan)
+	}
+}
+
+func TestPlanTaskRouterWorkerRoster_unmanagedWorkerConflict(t *testing.T) {
+	existing := []helper.RosterWorker{
+		{Sid: "WK1", FriendlyName: "Alice", Attributes: `{}`},
+	}
+	desired := map[string]helper.RosterWorkerConfiguration{
+		"Alice": {FriendlyName: "Alice", Attributes: `{}`},
+	}
+
+	_, err := helper.PlanWorkerRoster(existing, map[string]bool{}, desired, false)
+	if err == nil {
+		t.Fatal("expected an error but no error was returned")
+	}
+
+	expected := "The worker (Alice) already exists in the workspace with sid (WK1) and is not managed by the roster. Set unmanaged_workers to adopt to manage the worker"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestRunConcurrently(t *testing.T) {
+	var running, maxRunning, calls int32
+
+	errors := helper.RunConcurrently(context.Background(), 20, 3, func(ctx context.Context, index int) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		atomic.AddInt32(&calls, 1)
+
+		for {
+			previous := atomic.LoadInt32(&maxRunning)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+				break
+			}
+		}
+
+		if index%5 == 0 {
+			return fmt.Errorf("operation %d failed", index)
+		}
+		return nil
+	})
+
+	if calls != 20 {
+		t.Errorf("expected 20 operations to be called, got %d", calls)
+	}
+	if maxRunning > 3 {
+		t.Errorf("expected at most 3 operations to run concurrently, got %d", maxRunning)
+	}
+
+	expected := []string{"operation 0 failed", "operation 5 failed", "operation 10 failed", "operation 15 failed"}
+	if len(errors) != len(expected) {
+		t.Fatalf("expected %d errors, got %d", len(expected), len(errors))
+	}
+	for index, err := range errors {
+		if err.Error() != expected[index] {
+			t.Errorf("expected %q, got %q", expected[index], err.Error())
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package twilio
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/RJPearson94/terraform-provider-twilio/twilio/internal/services/chat/migration"
+)
+
+// MigrateChatStateCommand is the name of the provider binary subcommand which rewrites the chat resources in a state file to the conversations equivalent
+const MigrateChatStateCommand = "migrate-chat-state"
+
+// MigrateChatState reads a Terraform state file and writes the state with all twilio_chat_* resources rewritten to the twilio_conversations_* equivalent.
+// Only the state file is modified, the Twilio resources are not read or changed
+func MigrateChatState(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(MigrateChatStateCommand, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	stateFile := flags.String("state", "", "Path to the Terraform state file to migrate")
+	out := flags.String("out", "", "Path to write the migrated state file to. The migrated state is written to stdout when not specified")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *stateFile == "" {
+		fmt.Fprintln(stderr, "The -state flag must be specified")
+		flags.Usage()
+		return 2
+	}
+
+	state, err := os.ReadFile(*stateFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to read state file: %s\n", err.Error())
+		return 1
+	}
+
+	// The provider logs the service registration, which is only useful when the provider is served by Terraform
+	log.SetOutput(io.Discard)
+
+	migratedState, migratedResources, err := migration.Migrate(state, Provider().ResourcesMap)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	if *out != "" {
+		if err := os.WriteFile(*out, migratedState, 0600); err != nil {
+			fmt.Fprintf(stderr, "Failed to write state file: %s\n", err.Error())
+			return 1
+		}
+	} else {
+		fmt.Fprint(stdout, string(migratedState))
+	}
+
+	for _, migratedResource := range migratedResources {
+		fmt.Fprintf(stderr, "Migrated %s to %s\n", migratedResource.From, migratedResource.To)
+	}
+	if len(migratedResources) == 0 {
+		fmt.Fprintln(stderr, "No chat resources were found in the state")
+	}
+	return 0
+}
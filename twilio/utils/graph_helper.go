@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GraphShape determines how a node is drawn by the Mermaid and DOT renderers
+type GraphShape int
+
+const (
+	GraphShapeBox GraphShape = iota
+	GraphShapeDiamond
+	GraphShapeCylinder
+	GraphShapeRounded
+	GraphShapeStadium
+)
+
+var mermaidShapes = map[GraphShape][2]string{
+	GraphShapeBox:      {"[", "]"},
+	GraphShapeDiamond:  {"{", "}"},
+	GraphShapeCylinder: {"[(", ")]"},
+	GraphShapeRounded:  {"(", ")"},
+	GraphShapeStadium:  {"([", "])"},
+}
+
+var dotShapes = map[GraphShape]string{
+	GraphShapeBox:      "box",
+	GraphShapeDiamond:  "diamond",
+	GraphShapeCylinder: "cylinder",
+	GraphShapeRounded:  "ellipse",
+	GraphShapeStadium:  "oval",
+}
+
+// GraphNode is a node of a graph. Each line of the label is rendered on a separate line.
+// The mermaid ID is used in place of the ID in the Mermaid output when the ID is not a valid Mermaid identifier
+type GraphNode struct {
+	ID        string
+	MermaidID string
+	Label     string
+	Shape     GraphShape
+}
+
+// GraphEdge is a directed edge between two nodes of a graph
+type GraphEdge struct {
+	From   string
+	To     string
+	Label  string
+	Dashed bool
+}
+
+// Graph is a directed graph which can be rendered as a Mermaid flowchart or a Graphviz DOT digraph.
+// The direction is either TD (top down) or LR (left to right)
+type Graph struct {
+	Direction string
+	Nodes     []GraphNode
+	Edges     []GraphEdge
+}
+
+// Mermaid renders the graph as a Mermaid flowchart
+func (graph Graph) Mermaid() string {
+	escape := strings.NewReplacer(`"`, "#quot;", "\n", "<br/>").Replace
+
+	mermaidIDs := make(map[string]string)
+	for _, node := range graph.Nodes {
+		mermaidIDs[node.ID] = node.ID
+		if node.MermaidID != "" {
+			mermaidIDs[node.ID] = node.MermaidID
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("flowchart %s\n", graph.Direction))
+	for _, node := range graph.Nodes {
+		shape := mermaidShapes[node.Shape]
+		builder.WriteString(fmt.Sprintf("    %s%s\"%s\"%s\n", mermaidIDs[node.ID], shape[0], escape(node.Label), shape[1]))
+	}
+	for _, edge := range graph.Edges {
+		arrow := "-->"
+		if edge.Dashed {
+			arrow = "-.->"
+		}
+		if edge.Label != "" {
+			builder.WriteString(fmt.Sprintf("    %s %s|\"%s\"| %s\n", mermaidIDs[edge.From], arrow, escape(edge.Label), mermaidIDs[edge.To]))
+		} else {
+			builder.WriteString(fmt.Sprintf("    %s %s %s\n", mermaidIDs[edge.From], arrow, mermaidIDs[edge.To]))
+		}
+	}
+	return builder.String()
+}
+
+// DOT renders the graph as a Graphviz DOT digraph. The rank direction is omitted for top down graphs as this is the Graphviz default
+func (graph Graph) DOT() string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace
+
+	var builder strings.Builder
+	builder.WriteString("digraph {\n")
+	if graph.Direction != "TD" {
+		builder.WriteString(fmt.Sprintf("  rankdir=%s;\n", graph.Direction))
+	}
+	for _, node := range graph.Nodes {
+		builder.WriteString(fmt.Sprintf("  \"%s\" [label=\"%s\", shape=%s];\n", escape(node.ID), escape(node.Label), dotShapes[node.Shape]))
+	}
+	for _, edge := range graph.Edges {
+		attributes := []string{}
+		if edge.Label != "" {
+			attributes = append(attributes, fmt.Sprintf("label=\"%s\"", escape(edge.Label)))
+		}
+		if edge.Dashed {
+			attributes = append(attributes, "style=dashed")
+		}
+
+		if len(attributes) > 0 {
+			builder.WriteString(fmt.Sprintf("  \"%s\" -> \"%s\" [%s];\n", escape(edge.From), escape(edge.To), strings.Join(attributes, ", ")))
+		} else {
+			builder.WriteString(fmt.Sprintf("  \"%s\" -> \"%s\";\n", escape(edge.From), escape(edge.To)))
+		}
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
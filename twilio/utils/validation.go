@@ -83,6 +83,10 @@ func ConversationSidValidation() schema.SchemaValidateFunc {
 	return validation.StringMatch(regexp.MustCompile("^CH[0-9a-fA-F]{32}$"), "")
 }
 
+func ConversationParticipantSidValidation() schema.SchemaValidateFunc {
+	return validation.StringMatch(regexp.MustCompile("^MB[0-9a-fA-F]{32}$"), "")
+}
+
 func ConversationWebhookSidValidation() schema.SchemaValidateFunc {
 	return validation.StringMatch(regexp.MustCompile("^WH[0-9a-fA-F]{32}$"), "")
 }
@@ -151,6 +155,18 @@ func PhoneNumberValidation() schema.SchemaValidateFunc {
 	return validation.StringMatch(regexp.MustCompile(`^\+[1-9]\d{1,14}$`), "")
 }
 
+func PhoneNumberHostedNumberOrderSidValidation() schema.SchemaValidateFunc {
+	return validation.StringMatch(regexp.MustCompile("^HR[0-9a-fA-F]{32}$"), "")
+}
+
+func PhoneNumberPortInRequestSidValidation() schema.SchemaValidateFunc {
+	return validation.StringMatch(regexp.MustCompile("^KW[0-9a-fA-F]{32}$"), "")
+}
+
+func PhoneNumberSupportingDocumentSidValidation() schema.SchemaValidateFunc {
+	return validation.StringMatch(regexp.MustCompile("^RD[0-9a-fA-F]{32}$"), "")
+}
+
 // Proxy
 
 func ProxyServiceSidValidation() schema.SchemaValidateFunc {